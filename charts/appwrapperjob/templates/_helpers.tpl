@@ -30,3 +30,298 @@ Create chart name and version as used by the chart label.
 {{- define "appwrapperjob.chart" -}}
 {{- printf "%s-%s" .Chart.Name .Chart.Version | replace "+" "_" | trunc 63 | trimSuffix "-" -}}
 {{- end -}}
+
+{{/*
+Render the lifecycle policies of a Volcano Job or task, one of event and exitCode is set.
+*/}}
+{{- define "appwrapperjob.volcanoPolicies" -}}
+{{- range . }}
+{{- if .event }}
+- event: {{ .event | quote }}
+{{- else }}
+- exitCode: {{ .exitCode }}
+{{- end }}
+  action: {{ .action }}
+{{- if .timeout }}
+  timeout: {{ .timeout }}
+{{- end }}
+{{- end }}
+{{- end -}}
+
+{{/*
+Render the pod template of a role of a TFJob, MPIJob, RayJob, DeepSpeed TrainingJob or batch Job.
+Takes a dict with root (the top context), container (the container name), gpuCount, and optionally
+roleLabels (extra pod labels), restartPolicy (set on the pod) and noCommand (leave the entrypoint to the operator).
+*/}}
+{{- define "appwrapperjob.podTemplate" -}}
+{{- $root := .root -}}
+{{- $v := $root.Values -}}
+{{- $gpuCount := .gpuCount -}}
+{{- $syncMode := $v.syncMode -}}
+{{- $dataDirs := $v.dataDirs -}}
+metadata:
+  name: {{ $root.Release.Name }}
+  labels:
+    app: {{ template "appwrapperjob.name" $root }}
+    chart: {{ template "appwrapperjob.chart" $root }}
+    release: {{ $root.Release.Name }}
+    heritage: {{ $root.Release.Service }}
+    createdBy: "AppWrapperJob"
+    workload.codeflare.dev/appwrapper: {{ $root.Release.Name }}
+    {{- if $v.podGroupName }}
+    pod-group.scheduling.sigs.k8s.io/name: {{ $v.podGroupName }}
+    pod-group.scheduling.sigs.k8s.io/min-available: "{{ $v.podGroupMinAvailable }}"
+    {{- end }}
+    {{- range $key, $value := .roleLabels }}
+    {{ $key }}: {{ $value | quote }}
+    {{- end }}
+    {{- range $key, $value := $v.labels }}
+    {{ $key }}: {{ $value | quote }}
+    {{- end }}
+  annotations:
+    {{- range $key, $value := $v.annotations }}
+    {{ $key }}: {{ $value | quote }}
+    {{- end }}
+spec:
+  {{- if .restartPolicy }}
+  restartPolicy: {{ .restartPolicy }}
+  {{- end }}
+  {{- if ne (len $v.nodeSelectors) 0 }}
+  nodeSelector:
+  {{- range $nodeKey,$nodeVal := $v.nodeSelectors }}
+    {{ $nodeKey }}: "{{ $nodeVal }}"
+  {{- end }}
+  {{- end }}
+  {{- if ne (len $v.tolerations) 0 }}
+  tolerations:
+  {{- range $tolerationKey := $v.tolerations }}
+  - {{- if $tolerationKey.key }}
+    key: "{{ $tolerationKey.key }}"
+    {{- end }}
+    {{- if $tolerationKey.value }}
+    value: "{{ $tolerationKey.value }}"
+    {{- end }}
+    {{- if $tolerationKey.effect }}
+    effect: "{{ $tolerationKey.effect }}"
+    {{- end }}
+    {{- if $tolerationKey.operator }}
+    operator: "{{ $tolerationKey.operator }}"
+    {{- end }}
+    {{- if ne $tolerationKey.tolerationSeconds nil }}
+    tolerationSeconds: {{ $tolerationKey.tolerationSeconds }}
+    {{- end }}
+  {{- end }}
+  {{- end }}
+  {{- if $v.schedulerName }}
+  schedulerName: {{ $v.schedulerName }}
+  {{- end }}
+  {{- if $v.priorityClassName }}
+  priorityClassName: {{ $v.priorityClassName }}
+  {{- end }}
+  {{- if $v.useHostNetwork }}
+  {{- if not $v.useENI }}
+  hostNetwork: {{ $v.useHostNetwork }}
+  dnsPolicy: ClusterFirstWithHostNet
+  {{- end }}
+  {{- end }}
+  {{- if $v.useHostPID }}
+  hostPID: {{ $v.useHostPID }}
+  {{- end }}
+  {{- if $v.useHostIPC }}
+  hostIPC: {{ $v.useHostIPC }}
+  {{- end }}
+  {{- if $v.enablePodSecurityContext }}
+  {{- if $v.isNonRoot }}
+  securityContext:
+    runAsUser: {{ $v.podSecurityContext.runAsUser }}
+    runAsGroup: {{ $v.podSecurityContext.runAsGroup }}
+    runAsNonRoot: {{ $v.podSecurityContext.runAsNonRoot }}
+    supplementalGroups:
+      {{- range $group := $v.podSecurityContext.supplementalGroups }}
+      - {{ $group }}
+      {{- end }}
+  {{- end }}
+  {{- end }}
+  volumes:
+  {{- if ne (len $v.configFiles) 0 }}
+  {{- range $containerPathKey,$configFileInfos := $v.configFiles }}
+  - name: {{ $containerPathKey }}
+    configMap:
+      name: {{ $root.Release.Name }}-{{ $containerPathKey }}
+  {{- end }}
+  {{- end }}
+  {{- if $v.useTensorboard }}
+  {{- if $v.isLocalLogging }}
+  - hostPath:
+      path: "{{ $v.hostLogPath }}"
+    name: training-logs-volume
+  {{- end }}
+  {{- end }}
+  {{- if $syncMode }}
+  - name: code-sync
+    emptyDir: {}
+  {{- end }}
+  {{- if $v.nvidiaPath }}
+  - hostPath:
+      path: "{{ $v.nvidiaPath }}"
+    name: nvidia
+  {{- end }}
+  {{- if $v.dataset }}
+  {{- range $pvcName, $destPath := $v.dataset }}
+  - name: "{{ $pvcName }}"
+    persistentVolumeClaim:
+      claimName: "{{ $pvcName }}"
+  {{- end }}
+  {{- end }}
+  {{- if $dataDirs }}
+  {{- range $dataDirs }}
+  - hostPath:
+      path: {{ .hostPath }}
+    name: {{ .name }}
+  {{- end }}
+  {{- end }}
+  {{- if $v.shareMemory }}
+  - name: dshm
+    emptyDir:
+      medium: Memory
+      sizeLimit: {{ $v.shareMemory }}
+  {{- end }}
+  {{- if $syncMode }}
+  initContainers:
+  - name: init-code
+    {{- if $v.syncImage }}
+    image: "{{ $v.syncImage }}"
+    {{- else if eq $syncMode "rsync" }}
+    image: "{{ $v.rsyncImage }}"
+    {{- else if eq $syncMode "git" }}
+    image: "{{ $v.gitImage }}"
+    {{- end }}
+    imagePullPolicy: {{ $v.imagePullPolicy }}
+    {{- if eq "rsync" $syncMode }}
+    command: ["rsync", "-avP", "{{ $v.syncSource }}", "/code"]
+    {{- end }}
+    env:
+    {{- range $key, $value := $v.envs }}
+    - name: "{{ $key }}"
+      value: "{{ $value }}"
+    {{- end }}
+    {{- if eq "git" $syncMode }}
+    - name: GIT_SYNC_REPO
+      value: {{ $v.syncSource }}
+    - name: GIT_SYNC_DEST
+      value: {{ $v.syncGitProjectName }}
+    - name: GIT_SYNC_ROOT
+      value: /code
+    - name: GIT_SYNC_ONE_TIME
+      value: "true"
+    {{- end }}
+    volumeMounts:
+    - name: code-sync
+      mountPath: /code
+  {{- end }}
+  {{- if ne (len $v.imagePullSecrets) 0 }}
+  imagePullSecrets:
+  {{- range $imagePullSecret := $v.imagePullSecrets }}
+  - name: "{{ $imagePullSecret }}"
+  {{- end }}
+  {{- end }}
+  containers:
+  - image: "{{ $v.image }}"
+    name: {{ .container }}
+    imagePullPolicy: {{ $v.imagePullPolicy }}
+    {{- if $v.workingDir }}
+    workingDir: {{ $v.workingDir }}
+    {{- end }}
+    {{- if not .noCommand }}
+    command:
+    - "{{ $v.shell }}"
+    - "-c"
+    - {{ $v.command }}
+    {{- end }}
+    resources:
+      requests:
+        {{- include "appwrapperjob.resources" (dict "values" $v "gpuCount" $gpuCount) | trim | nindent 8 }}
+      limits:
+        {{- include "appwrapperjob.resources" (dict "values" $v "gpuCount" $gpuCount) | trim | nindent 8 }}
+    env:
+    {{- range $key, $value := $v.envs }}
+    - name: "{{ $key }}"
+      value: "{{ $value }}"
+    {{- end }}
+    {{- if $v.privileged }}
+    securityContext:
+      privileged: true
+    {{- else if $v.enableRDMA }}
+    securityContext:
+      capabilities:
+        add:
+        - IPC_LOCK
+    {{- end }}
+    volumeMounts:
+    {{- if ne (len $v.configFiles) 0 }}
+    {{- range $containerPathKey,$configFileInfos := $v.configFiles }}
+    {{- $visit := "false" }}
+    {{- range $cofigFileKey,$configFileInfo := $configFileInfos }}
+    {{- if eq "false" $visit }}
+    - mountPath: {{ $configFileInfo.containerFilePath }}
+      name: {{ $containerPathKey }}
+    {{- $visit = "true" }}
+    {{- end }}
+    {{- end }}
+    {{- end }}
+    {{- end }}
+    {{- if $v.useTensorboard }}
+    {{- if $v.isLocalLogging }}
+    - mountPath: {{ $v.trainingLogdir }}
+      name: training-logs-volume
+    {{- end }}
+    {{- end }}
+    {{- if $syncMode }}
+    - name: code-sync
+      mountPath: {{ if $v.workingDir }}{{ $v.workingDir }}/code{{ else }}/code{{ end }}
+    {{- end }}
+    {{- if $v.nvidiaPath }}
+    - mountPath: /usr/local/nvidia
+      name: nvidia
+    {{- end }}
+    {{- range $pvcName, $destPath := $v.dataset }}
+    - name: "{{ $pvcName }}"
+      mountPath: "{{ $destPath }}"
+    {{- end }}
+    {{- if $v.shareMemory }}
+    - mountPath: /dev/shm
+      name: dshm
+    {{- end }}
+    {{- range $dataDirs }}
+    - mountPath: {{ .containerPath }}
+      name: {{ .name }}
+    {{- end }}
+{{- end -}}
+
+{{/*
+Render the resource list of a training container, takes a dict with values and gpuCount.
+*/}}
+{{- define "appwrapperjob.resources" -}}
+{{- $v := .values }}
+{{- if gt (int .gpuCount) 0 }}
+{{- if $v.acceleratorResource }}
+{{ $v.acceleratorResource }}: {{ .gpuCount | quote }}
+{{- else if $v.nvidiaPath }}
+alpha.kubernetes.io/nvidia-gpu: {{ .gpuCount | quote }}
+{{- else }}
+nvidia.com/gpu: {{ .gpuCount | quote }}
+{{- end }}
+{{- end }}
+{{- range $key, $value := $v.devices }}
+{{ $key }}: {{ $value }}
+{{- end }}
+{{- if $v.cpu }}
+cpu: {{ $v.cpu | quote }}
+{{- end }}
+{{- if $v.memory }}
+memory: {{ $v.memory | quote }}
+{{- end }}
+{{- if $v.enableRDMA }}
+rdma/hca: "1"
+{{- end }}
+{{- end -}}
@@ -62,7 +62,7 @@ func main() {
 
 	if err := commands.NewCommand().Execute(); err != nil {
 		utils.PrintErrorMessage(err.Error())
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 }
 
@@ -0,0 +1,124 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubeflow/arena/pkg/logarchive"
+)
+
+const (
+	// logArchiverMode runs the job monitor as the log archiver of the training jobs
+	logArchiverMode = "log-archiver"
+
+	defaultArchiveDir    = "/var/log/arena"
+	defaultListenAddress = ":" + logarchive.ServicePort
+	// pruneInterval is the interval to remove the archived logs out of the retention limits
+	pruneInterval = 10 * time.Minute
+)
+
+// runLogArchiver archives the logs of the training jobs in ARCHIVE_DIR by attempt and serves them on
+// LISTEN_ADDRESS through the service proxy of the api server, the pods in WATCH_NAMESPACE are watched
+// or all the pods if it is not set. The attempts not written for RETENTION (e.g. 720h) are removed, and
+// the least recently written attempts are removed when the archive is larger than MAX_SIZE (e.g. 18Gi)
+func runLogArchiver() {
+	archiveDir := getEnv("ARCHIVE_DIR", defaultArchiveDir)
+	listenAddress := getEnv("LISTEN_ADDRESS", defaultListenAddress)
+	watchNamespace := os.Getenv("WATCH_NAMESPACE")
+	var retention time.Duration
+	if value := os.Getenv("RETENTION"); value != "" {
+		var err error
+		if retention, err = time.ParseDuration(value); err != nil {
+			panic(fmt.Sprintf("invalid RETENTION %v: %v", value, err))
+		}
+	}
+	var maxSize int64
+	if value := os.Getenv("MAX_SIZE"); value != "" {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			panic(fmt.Sprintf("invalid MAX_SIZE %v: %v", value, err))
+		}
+		maxSize = quantity.Value()
+	}
+	log.Infof("archive the logs of the training jobs in %s, namespace: %q, listen address: %s, retention: %v, max size: %v",
+		archiveDir, watchNamespace, listenAddress, retention, maxSize)
+
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		panic(err.Error())
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		panic(err.Error())
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	store := logarchive.NewStore(archiveDir)
+	server := &http.Server{Addr: listenAddress, Handler: logarchive.NewHandler(store)}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Errorf("failed to serve the archived logs: %v", err)
+			cancel()
+		}
+	}()
+	if retention > 0 || maxSize > 0 {
+		go pruneArchive(ctx, store, retention, maxSize)
+	}
+	if err := logarchive.NewArchiver(clientset, dynamicClient, store, watchNamespace).Run(ctx); err != nil {
+		panic(err.Error())
+	}
+	_ = server.Shutdown(context.Background())
+}
+
+// pruneArchive removes the archived logs out of the retention limits every pruneInterval until the context is done
+func pruneArchive(ctx context.Context, store *logarchive.Store, retention time.Duration, maxSize int64) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+	for {
+		if err := store.Prune(retention, maxSize); err != nil {
+			log.Warnf("failed to prune the archived logs: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -44,6 +44,14 @@ var (
 
 // Receive Namespace, Job Name, Statefulset name
 func main() {
+	switch os.Getenv("MODE") {
+	case logArchiverMode:
+		runLogArchiver()
+		return
+	case rankTablePublisherMode:
+		runRankTablePublisher()
+		return
+	}
 
 	// 1. Get the job, statefulset and namespace
 	err := updateInputFromEnv()
@@ -0,0 +1,53 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubeflow/arena/pkg/ranktable"
+)
+
+// rankTablePublisherMode runs the job monitor as the publisher of the Ascend rank tables
+const rankTablePublisherMode = "rank-table-publisher"
+
+// runRankTablePublisher fills the rank table ConfigMaps of the jobs submitted with --rank-table from
+// the device annotations of their pods, the jobs in WATCH_NAMESPACE are watched or all the jobs if it is not set
+func runRankTablePublisher() {
+	watchNamespace := os.Getenv("WATCH_NAMESPACE")
+	log.Infof("publish the rank tables of the training jobs, namespace: %q", watchNamespace)
+
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		panic(err.Error())
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	if err := ranktable.NewPublisher(clientset, watchNamespace).Run(ctx); err != nil {
+		panic(err.Error())
+	}
+}
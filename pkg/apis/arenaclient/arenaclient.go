@@ -61,7 +61,11 @@ func NewArenaClient(args types.ArenaClientArgs) (*ArenaClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := k8saccesser.InitK8sResourceAccesser(configer.GetRestConfig(), configer.GetClientSet(), configer.IsDaemonMode()); err != nil {
+	cacheNamespaces := []string{}
+	if args.DaemonNamespaced {
+		cacheNamespaces = append(cacheNamespaces, configer.GetNamespace())
+	}
+	if err := k8saccesser.InitK8sResourceAccesser(configer.GetRestConfig(), configer.GetClientSet(), configer.IsDaemonMode(), cacheNamespaces...); err != nil {
 		return client, err
 	}
 	client.arenaConfiger = configer
@@ -93,6 +97,10 @@ func (a *ArenaClient) Data() *DataClient {
 	return NewDataClient(a.namespace, a.arenaConfiger)
 }
 
+func (a *ArenaClient) Queue() *QueueClient {
+	return NewQueueClient(a.namespace, a.arenaConfiger)
+}
+
 func (a *ArenaClient) Evaluate() *EvaluateClient {
 	return NewEvaluateClient(a.namespace, a.arenaConfiger)
 }
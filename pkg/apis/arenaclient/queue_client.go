@@ -0,0 +1,57 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arenaclient
+
+import (
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/queue"
+)
+
+type QueueClient struct {
+	namespace string
+	configer  *config.ArenaConfiger
+}
+
+// NewQueueClient creates a QueueClient
+func NewQueueClient(namespace string, configer *config.ArenaConfiger) *QueueClient {
+	return &QueueClient{
+		namespace: namespace,
+		configer:  configer,
+	}
+}
+
+// Namespace sets the namespace,this operation does not change the default namespace
+func (q *QueueClient) Namespace(namespace string) *QueueClient {
+	copyQueueClient := &QueueClient{
+		namespace: namespace,
+		configer:  q.configer,
+	}
+	return copyQueueClient
+}
+
+// List returns the Kueue LocalQueues
+func (q *QueueClient) List(allNamespaces bool) ([]*types.KueueLocalQueueInfo, error) {
+	return queue.ListLocalQueues(q.namespace, allNamespaces)
+}
+
+// ListAndPrint lists and prints the Kueue LocalQueues
+func (q *QueueClient) ListAndPrint(allNamespaces bool, format string) error {
+	queues, err := q.List(allNamespaces)
+	if err != nil {
+		return err
+	}
+	return queue.DisplayLocalQueues(queues, format, allNamespaces)
+}
@@ -15,8 +15,9 @@
 package arenaclient
 
 import (
+	"context"
 	"fmt"
-	"time"
+	"os"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
 	apistraining "github.com/kubeflow/arena/pkg/apis/training"
@@ -145,6 +146,20 @@ func (t *TrainingJobClient) GetAndPrint(jobName string, jobType types.TrainingJo
 	return nil
 }
 
+// GetAndWatch prints the job information like GetAndPrint and refreshes it on every change until the context is done,
+// the arena client must be created in daemon mode
+func (t *TrainingJobClient) GetAndWatch(ctx context.Context, jobName string, jobType types.TrainingJobType, format string, showEvent bool, showGPU bool) error {
+	job, err := training.SearchTrainingJob(jobName, t.namespace, jobType)
+	if err != nil {
+		if err == types.ErrTrainingJobNotFound {
+			return fmt.Errorf(errJobNotFoundMessage, jobName, t.namespace)
+		}
+		return err
+	}
+	mv := searchModelVersionByJobLabels(t.namespace, t.configer, job.GetLabels())
+	return training.WatchTrainingJob(ctx, jobName, t.namespace, job.Trainer(), mv, format, showEvent, showGPU)
+}
+
 // List returns all training jobs
 func (t *TrainingJobClient) List(allNamespaces bool, trainingType types.TrainingJobType, showPrometheusMetric bool) ([]*types.TrainingJobInfo, error) {
 	jobs, err := training.ListTrainingJobs(t.namespace, allNamespaces, trainingType)
@@ -172,6 +187,15 @@ func (t *TrainingJobClient) ListAndPrint(allNamespaces bool, format string, trai
 	return nil
 }
 
+// ListAndWatch prints the job informations like ListAndPrint and refreshes them on every change until the context is done,
+// the arena client must be created in daemon mode
+func (t *TrainingJobClient) ListAndWatch(ctx context.Context, allNamespaces bool, format string, trainingType types.TrainingJobType) error {
+	if trainingType == types.UnknownTrainingJob {
+		return fmt.Errorf("unsupport job type,arena only supports: [%v]", utils.GetSupportTrainingJobTypesInfo())
+	}
+	return training.WatchTrainingJobList(ctx, t.namespace, allNamespaces, trainingType, format)
+}
+
 // Logs returns the training job log
 func (t *TrainingJobClient) Logs(jobName string, jobType types.TrainingJobType, args *types.LogArgs) error {
 	args.Namespace = t.namespace
@@ -215,6 +239,45 @@ func (t *TrainingJobClient) Delete(jobType types.TrainingJobType, jobNames ...st
 	return nil
 }
 
+// Suspend suspends the target training job, only appwrapperjob supports it now
+func (t *TrainingJobClient) Suspend(jobName string, jobType types.TrainingJobType) error {
+	return training.SuspendTrainingJob(jobName, t.namespace, jobType, true)
+}
+
+// Resume resumes the suspended training job
+func (t *TrainingJobClient) Resume(jobName string, jobType types.TrainingJobType) error {
+	return training.SuspendTrainingJob(jobName, t.namespace, jobType, false)
+}
+
+// Resubmit submits the training job again from its stored values with a new name or after deleting it
+func (t *TrainingJobClient) Resubmit(jobName string, jobType types.TrainingJobType, args *types.ResubmitArgs) error {
+	return training.ResubmitTrainingJob(jobName, t.namespace, jobType, args)
+}
+
+// Export returns the stored values of the training job as a spec file
+func (t *TrainingJobClient) Export(jobName string, jobType types.TrainingJobType) ([]byte, error) {
+	return training.ExportTrainingJob(jobName, t.namespace, jobType)
+}
+
+// Wait blocks until the training job satisfies the predicate or the context is done
+func (t *TrainingJobClient) Wait(ctx context.Context, jobName string, jobType types.TrainingJobType, predicate types.TrainingJobPredicate) (*types.TrainingJobInfo, error) {
+	return training.WaitTrainingJob(ctx, jobName, t.namespace, jobType, predicate)
+}
+
+// Diagnose runs the checkers over the training job and returns the problems found, the most severe first
+func (t *TrainingJobClient) Diagnose(jobName string, jobType types.TrainingJobType) (*types.DiagnoseResult, error) {
+	return training.DiagnoseTrainingJob(jobName, t.namespace, jobType)
+}
+
+// DiagnoseAndPrint diagnoses the training job and prints the result in the format, one of json|yaml|wide
+func (t *TrainingJobClient) DiagnoseAndPrint(jobName string, jobType types.TrainingJobType, format string) error {
+	result, err := training.DiagnoseTrainingJob(jobName, t.namespace, jobType)
+	if err != nil {
+		return err
+	}
+	return training.PrintDiagnoseResult(os.Stdout, result, format)
+}
+
 // LogViewer returns the log viewer
 func (t *TrainingJobClient) LogViewer(jobName string, jobType types.TrainingJobType) ([]string, error) {
 	job, err := training.SearchTrainingJob(jobName, t.namespace, jobType)
@@ -224,9 +287,10 @@ func (t *TrainingJobClient) LogViewer(jobName string, jobType types.TrainingJobT
 	return job.GetJobDashboards(t.configer.GetClientSet(), t.namespace, t.arenaSystemNamespace)
 }
 
-// Prune cleans the not running training jobs
-func (t *TrainingJobClient) Prune(allNamespaces bool, since time.Duration) error {
-	return training.PruneTrainingJobs(t.namespace, allNamespaces, since)
+// Prune deletes the not running training jobs selected by the args and returns the deleted jobs,
+// the jobs which would be deleted are returned without deleting them if DryRun is set
+func (t *TrainingJobClient) Prune(args types.PruneArgs) ([]*types.PrunedTrainingJob, error) {
+	return training.PruneTrainingJobs(t.namespace, args)
 }
 
 func (t *TrainingJobClient) Top(args []string, allNamespaces bool, jobType types.TrainingJobType, instanceName string, notStop bool, format types.FormatStyle) error {
@@ -40,6 +40,9 @@ const (
 	DefaultArenaConfigPath      = "~/.arena/config"
 	GlobalConfigmapName         = "arena-config"
 	AdminUserKeyInConfigmap     = "adminUsers"
+	// AcceleratorResourcesKey is the key of the extended resources counted as gpus,
+	// e.g. "huawei.com/Ascend910,amd.com/gpu,habana.ai/gaudi"
+	AcceleratorResourcesKey = "acceleratorResources"
 )
 
 var arenaClient *ArenaConfiger
@@ -55,6 +58,11 @@ func InitArenaConfiger(args types.ArenaClientArgs) (*ArenaConfiger, error) {
 	return arenaClient, errInitArenaClient
 }
 
+// IsArenaConfigerInitialized tells whether the arena configer is initialized by InitArenaConfiger(...)
+func IsArenaConfigerInitialized() bool {
+	return arenaClient != nil
+}
+
 // GetArenaConfiger returns the arena configer,it must be invoked after invoking function InitArenaConfiger(...)
 func GetArenaConfiger() *ArenaConfiger {
 	if arenaClient == nil {
@@ -106,6 +114,7 @@ type ArenaConfiger struct {
 	clusterInstalledCRDs   []string
 	isolateUserInNamespace bool
 	tokenRetriever         *tokenRetriever
+	acceleratorResources   []string
 }
 
 func newArenaConfiger(args types.ArenaClientArgs) (*ArenaConfiger, error) {
@@ -143,6 +152,8 @@ func newArenaConfiger(args types.ArenaClientArgs) (*ArenaConfiger, error) {
 	log.Debugf("the user id is %v", userId)
 	data := getGlobalConfigFromConfigmap(args.ArenaNamespace, clientSet)
 	adminUsers := getAdminUserFromConfigmap(data)
+	acceleratorResources := getAcceleratorResources(arenaConfigs, data)
+	log.Debugf("accelerator resources counted as gpus: %v", acceleratorResources)
 	i, err := isolateUserInNamespace(namespace, clientSet)
 	if err != nil {
 		return nil, err
@@ -162,6 +173,7 @@ func newArenaConfiger(args types.ArenaClientArgs) (*ArenaConfiger, error) {
 		adminUsers:             adminUsers,
 		isolateUserInNamespace: i,
 		tokenRetriever:         tr,
+		acceleratorResources:   acceleratorResources,
 	}, nil
 
 }
@@ -220,6 +232,11 @@ func (a *ArenaConfiger) GetAdminUsers() []User {
 	return a.adminUsers
 }
 
+// GetAcceleratorResources returns the resources which are counted as gpus, nvidia.com/gpu is always the first one
+func (a *ArenaConfiger) GetAcceleratorResources() []string {
+	return a.acceleratorResources
+}
+
 func (a *ArenaConfiger) IsAdminUser() bool {
 	for _, admin := range a.adminUsers {
 		if a.user.GetId() == admin.GetId() {
@@ -259,6 +276,27 @@ func getAdminUserFromConfigmap(data map[string]string) []User {
 	return users
 }
 
+// getAcceleratorResources returns nvidia.com/gpu and the configured accelerator resources,
+// the config file takes precedence over the configmap
+func getAcceleratorResources(fileConfigs map[string]string, configmapData map[string]string) []string {
+	resources := []string{types.NvidiaGPUResourceName}
+	val, ok := fileConfigs[AcceleratorResourcesKey]
+	if !ok {
+		val, ok = configmapData[AcceleratorResourcesKey]
+	}
+	if !ok {
+		return resources
+	}
+	for _, name := range strings.Split(val, ",") {
+		name = strings.Trim(name, " ")
+		if name == "" || name == types.NvidiaGPUResourceName {
+			continue
+		}
+		resources = append(resources, name)
+	}
+	return resources
+}
+
 // loadArenaConifg returns configs in map
 func loadArenaConifg() (map[string]string, error) {
 	arenaConfigs := map[string]string{}
@@ -83,6 +83,44 @@ func (l *LoggerBuilder) Tail(line int) *LoggerBuilder {
 	return l
 }
 
+// AllInstances streams the logs of all the instances of a training job
+func (l *LoggerBuilder) AllInstances() *LoggerBuilder {
+	l.args.AllInstances = true
+	return l
+}
+
+// Role streams the logs of the instances of the role of a training job
+func (l *LoggerBuilder) Role(role string) *LoggerBuilder {
+	l.args.Role = role
+	return l
+}
+
+// Ranks streams the logs of the instances of the ranks of a training job, e.g. 0-7,9
+func (l *LoggerBuilder) Ranks(ranks string) *LoggerBuilder {
+	l.args.Ranks = ranks
+	return l
+}
+
+// Merge orders the lines of the instances by their timestamps
+func (l *LoggerBuilder) Merge() *LoggerBuilder {
+	l.args.Merge = true
+	return l
+}
+
+// Grep only prints the lines matching the regular expression
+func (l *LoggerBuilder) Grep(pattern string) *LoggerBuilder {
+	l.args.Grep = pattern
+	return l
+}
+
+// Attempt prints the logs of the attempt of a training job archived by the log archiver, 0 is the original run
+func (l *LoggerBuilder) Attempt(attempt int) *LoggerBuilder {
+	if attempt >= 0 {
+		l.args.Attempt = &attempt
+	}
+	return l
+}
+
 func (l *LoggerBuilder) WriterCloser(writerCloser io.WriteCloser) *LoggerBuilder {
 	if writerCloser != nil {
 		l.args.WriterCloser = writerCloser
@@ -378,7 +378,13 @@ func (b *AppWrapperJobBuilder) SuccessTTL(ttl string) *AppWrapperJobBuilder {
 	return b
 }
 
-// InnerJobType sets the inner job type ("pytorch" or "volcano")
+// Suspend submits the AppWrapper in suspended state
+func (b *AppWrapperJobBuilder) Suspend() *AppWrapperJobBuilder {
+	b.args.Suspend = true
+	return b
+}
+
+// InnerJobType sets the inner job type ("pytorch", "volcano", "tfjob", "mpijob", "deepspeedjob", "rayjob" or "job")
 func (b *AppWrapperJobBuilder) InnerJobType(jobType string) *AppWrapperJobBuilder {
 	if jobType != "" {
 		b.args.InnerJobType = jobType
@@ -386,6 +392,22 @@ func (b *AppWrapperJobBuilder) InnerJobType(jobType string) *AppWrapperJobBuilde
 	return b
 }
 
+// ========== TFJob specific methods ==========
+
+// PSCount sets the number of parameter servers of the TFJob
+func (b *AppWrapperJobBuilder) PSCount(count int) *AppWrapperJobBuilder {
+	if count > 0 {
+		b.args.PSCount = count
+	}
+	return b
+}
+
+// UseChief adds a Chief replica to the TFJob
+func (b *AppWrapperJobBuilder) UseChief() *AppWrapperJobBuilder {
+	b.args.UseChief = true
+	return b
+}
+
 // ========== Volcano Job specific methods ==========
 
 // MinAvailable sets the minimum available pods for gang scheduling (Volcano)
@@ -428,6 +450,39 @@ func (b *AppWrapperJobBuilder) Replicas(replicas int32) *AppWrapperJobBuilder {
 	return b
 }
 
+// Tasks defines the tasks of a multi-task Volcano Job, each item is like "name=worker,replicas=8,gpus=8"
+func (b *AppWrapperJobBuilder) Tasks(tasks []string) *AppWrapperJobBuilder {
+	if len(tasks) != 0 {
+		b.argValues["task"] = &tasks
+	}
+	return b
+}
+
+// VolcanoQueue sets the Volcano queue of the job, it is independent of the Kueue queue
+func (b *AppWrapperJobBuilder) VolcanoQueue(queue string) *AppWrapperJobBuilder {
+	if queue != "" {
+		b.args.VolcanoQueue = queue
+	}
+	return b
+}
+
+// Policies defines the lifecycle policies of the Volcano Job, each item is like
+// "event=PodEvicted,action=RestartJob" and a "task=<name>" key makes it a task level policy
+func (b *AppWrapperJobBuilder) Policies(policies []string) *AppWrapperJobBuilder {
+	if len(policies) != 0 {
+		b.argValues["policy"] = &policies
+	}
+	return b
+}
+
+// VolcanoPlugins enables extra Volcano job plugins, supports ssh and env
+func (b *AppWrapperJobBuilder) VolcanoPlugins(plugins []string) *AppWrapperJobBuilder {
+	if len(plugins) != 0 {
+		b.args.Plugins = plugins
+	}
+	return b
+}
+
 // MasterPort sets the port for distributed training communication
 func (b *AppWrapperJobBuilder) MasterPort(port int32) *AppWrapperJobBuilder {
 	if port > 0 {
@@ -499,6 +554,28 @@ func (b *AppWrapperJobBuilder) RingController(controller string) *AppWrapperJobB
 	return b
 }
 
+// RankTable publishes the Ascend rank table of the job as a ConfigMap mounted into every pod
+func (b *AppWrapperJobBuilder) RankTable() *AppWrapperJobBuilder {
+	b.args.RankTable = true
+	return b
+}
+
+// NPUs sets the number of accelerators (e.g. Ascend NPUs) of each replica
+func (b *AppWrapperJobBuilder) NPUs(count int) *AppWrapperJobBuilder {
+	if count > 0 {
+		b.args.AcceleratorCount = count
+	}
+	return b
+}
+
+// AcceleratorResource sets the resource name of the accelerator requested by NPUs, e.g. "huawei.com/Ascend910"
+func (b *AppWrapperJobBuilder) AcceleratorResource(name string) *AppWrapperJobBuilder {
+	if name != "" {
+		b.args.AcceleratorResource = name
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *AppWrapperJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
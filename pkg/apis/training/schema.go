@@ -0,0 +1,52 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+// SubmitArgsSchema returns the JSON schema of the spec file accepted by `arena submit <type> -f`
+func SubmitArgsSchema(jobType types.TrainingJobType) ([]byte, error) {
+	var args interface{}
+	switch jobType {
+	case types.TFTrainingJob:
+		args = types.SubmitTFJobArgs{}
+	case types.PytorchTrainingJob:
+		args = types.SubmitPyTorchJobArgs{}
+	case types.MPITrainingJob:
+		args = types.SubmitMPIJobArgs{}
+	case types.HorovodTrainingJob:
+		args = types.SubmitHorovodJobArgs{}
+	case types.VolcanoTrainingJob:
+		args = types.SubmitVolcanoJobArgs{}
+	case types.ETTrainingJob:
+		args = types.SubmitETJobArgs{}
+	case types.SparkTrainingJob:
+		args = types.SubmitSparkJobArgs{}
+	case types.DeepSpeedTrainingJob:
+		args = types.SubmitDeepSpeedJobArgs{}
+	case types.RayJob:
+		args = types.SubmitRayJobArgs{}
+	case types.AppWrapperJob:
+		args = types.SubmitAppWrapperJobArgs{}
+	default:
+		return nil, fmt.Errorf("unknown job type %v", jobType)
+	}
+	return argsbuilder.SubmitArgsSchema(fmt.Sprintf("%v spec", jobType), args)
+}
@@ -21,6 +21,8 @@ const (
 const (
 	// defines the nvidia resource name
 	NvidiaGPUResourceName = "nvidia.com/gpu"
+	// defines the default huawei ascend npu resource name
+	AscendNPUResourceName = "huawei.com/Ascend910"
 )
 
 const (
@@ -0,0 +1,57 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// DiagnoseSeverity defines how likely a finding is the root cause of the problem of a training job
+type DiagnoseSeverity string
+
+const (
+	// DiagnoseCritical means the finding stops the training job from running or makes it fail
+	DiagnoseCritical DiagnoseSeverity = "CRITICAL"
+	// DiagnoseWarning means the finding may slow down or break the training job
+	DiagnoseWarning DiagnoseSeverity = "WARNING"
+	// DiagnoseInfo means the finding is worth knowing but unlikely the root cause
+	DiagnoseInfo DiagnoseSeverity = "INFO"
+)
+
+// DiagnoseFinding is a problem of a training job found by a checker
+type DiagnoseFinding struct {
+	// Checker is the name of the checker which finds the problem
+	Checker string `json:"checker" yaml:"checker"`
+	// Severity is the severity of the problem
+	Severity DiagnoseSeverity `json:"severity" yaml:"severity"`
+	// Object is the object having the problem, e.g. pod/test-worker-0
+	Object string `json:"object" yaml:"object"`
+	// Reason is the machine readable reason of the problem, e.g. OOMKilled
+	Reason string `json:"reason" yaml:"reason"`
+	// Message is the human readable description of the problem
+	Message string `json:"message" yaml:"message"`
+	// Suggestion is the suggested fix of the problem
+	Suggestion string `json:"suggestion,omitempty" yaml:"suggestion,omitempty"`
+}
+
+// DiagnoseResult is the diagnosis of a training job, the findings are ranked by severity
+type DiagnoseResult struct {
+	// Name is the name of the training job
+	Name string `json:"name" yaml:"name"`
+	// Namespace is the namespace of the training job
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Type is the type of the training job
+	Type TrainingJobType `json:"type" yaml:"type"`
+	// Status is the status of the training job
+	Status TrainingJobStatus `json:"status" yaml:"status"`
+	// Findings are the problems of the training job, the most severe first
+	Findings []DiagnoseFinding `json:"findings" yaml:"findings"`
+}
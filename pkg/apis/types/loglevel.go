@@ -44,4 +44,16 @@ type LogArgs struct {
 	RetryCnt      int
 	RetryTimeout  time.Duration
 	WriterCloser  io.WriteCloser
+	// AllInstances streams the logs of all the instances of the training job
+	AllInstances bool
+	// Role limits the instances to the role, e.g. worker
+	Role string
+	// Ranks limits the instances to the ranks, e.g. 0-7,9
+	Ranks string
+	// Merge orders the lines of all the instances by their timestamps
+	Merge bool
+	// Grep only prints the lines matching the regular expression
+	Grep string
+	// Attempt prints the logs of the attempt archived by the log archiver, 0 is the original run
+	Attempt *int
 }
@@ -0,0 +1,74 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// KueueWorkloadInfo stores the Kueue admission state of a training job
+type KueueWorkloadInfo struct {
+	// Name is the name of the Kueue Workload created for the job
+	Name string `json:"name" yaml:"name"`
+	// LocalQueue is the LocalQueue the job is submitted to
+	LocalQueue string `json:"localQueue" yaml:"localQueue"`
+	// ClusterQueue is the ClusterQueue which admitted the job, empty until quota is reserved
+	ClusterQueue string `json:"clusterQueue,omitempty" yaml:"clusterQueue,omitempty"`
+	// QuotaReserved is true when the Workload has reserved quota in the ClusterQueue
+	QuotaReserved bool `json:"quotaReserved" yaml:"quotaReserved"`
+	// Admitted is true when the Workload has reserved quota and passed all admission checks
+	Admitted bool `json:"admitted" yaml:"admitted"`
+	// PendingReason is the reason why the Workload is not admitted yet
+	PendingReason string `json:"pendingReason,omitempty" yaml:"pendingReason,omitempty"`
+	// PendingMessage is the human readable message of the pending reason
+	PendingMessage string `json:"pendingMessage,omitempty" yaml:"pendingMessage,omitempty"`
+	// QueuePosition is the position of the pending Workload in its LocalQueue, starting from 1.
+	// Workloads are ordered by priority and then creation time, 0 means not pending
+	QueuePosition int `json:"queuePosition,omitempty" yaml:"queuePosition,omitempty"`
+}
+
+// PodGroupInfo stores the gang scheduling state of the volcano PodGroup of a job
+type PodGroupInfo struct {
+	// Name is the name of the PodGroup
+	Name string `json:"name" yaml:"name"`
+	// Queue is the volcano queue the PodGroup is submitted to
+	Queue string `json:"queue,omitempty" yaml:"queue,omitempty"`
+	// Phase is the phase of the PodGroup, one of Pending|Inqueue|Running|Unknown|Completed
+	Phase string `json:"phase" yaml:"phase"`
+	// MinMember is the minimal number of pods which must be scheduled together
+	MinMember int32 `json:"minMember" yaml:"minMember"`
+	// Running is the number of the running pods
+	Running int32 `json:"running" yaml:"running"`
+	// Succeeded is the number of the succeeded pods
+	Succeeded int32 `json:"succeeded" yaml:"succeeded"`
+	// Failed is the number of the failed pods
+	Failed int32 `json:"failed" yaml:"failed"`
+	// UnschedulableMessage is the message of the Unschedulable condition, empty if the gang is scheduled
+	UnschedulableMessage string `json:"unschedulableMessage,omitempty" yaml:"unschedulableMessage,omitempty"`
+}
+
+// KueueLocalQueueInfo stores the summary of a Kueue LocalQueue
+type KueueLocalQueueInfo struct {
+	// Name is the name of the LocalQueue
+	Name string `json:"name" yaml:"name"`
+	// Namespace is the namespace of the LocalQueue
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// ClusterQueue is the ClusterQueue backing the LocalQueue
+	ClusterQueue string `json:"clusterQueue" yaml:"clusterQueue"`
+	// PendingWorkloads is the number of Workloads not admitted yet
+	PendingWorkloads int32 `json:"pendingWorkloads" yaml:"pendingWorkloads"`
+	// ReservingWorkloads is the number of Workloads reserving quota
+	ReservingWorkloads int32 `json:"reservingWorkloads" yaml:"reservingWorkloads"`
+	// AdmittedWorkloads is the number of admitted Workloads
+	AdmittedWorkloads int32 `json:"admittedWorkloads" yaml:"admittedWorkloads"`
+	// StopPolicy shows whether the LocalQueue is stopped, e.g. Hold or HoldAndDrain
+	StopPolicy string `json:"stopPolicy,omitempty" yaml:"stopPolicy,omitempty"`
+}
@@ -204,6 +204,8 @@ type CommonServingArgs struct {
 	ConfigFiles map[string]map[string]ConfigFileInfo `yaml:"configFiles"`
 	// HelmOptions stores the helm options
 	HelmOptions []string `yaml:"-"`
+	// DryRun only prints the rendered manifests instead of submitting the job
+	DryRun DryRunStrategy `yaml:"-"` // --dry-run
 
 	ModelServiceExists bool `yaml:"modelServiceExists"` // --modelServiceExists
 
@@ -115,6 +115,9 @@ type CommonSubmitArgs struct {
 	// HelmOptions stores the helm options
 	HelmOptions []string `yaml:"-"`
 
+	// DryRun only prints the rendered manifests instead of submitting the job,match option --dry-run
+	DryRun DryRunStrategy `yaml:"-"`
+
 	// EnableSpotInstance enables the feature of SuperVisor manage spot instance training.
 	EnableSpotInstance bool `yaml:"enableSpotInstance"`
 
@@ -76,10 +76,22 @@ type SubmitAppWrapperJobArgs struct {
 	// Format: "1h", "24h", etc. If not set, the AppWrapper will not be auto-deleted.
 	SuccessTTL string `yaml:"successTTL,omitempty"`
 
+	// Suspend submits the AppWrapper in suspended state, the job can be
+	// started later with `arena resume`
+	Suspend bool `yaml:"suspend,omitempty"`
+
 	// InnerJobType specifies the type of job wrapped inside AppWrapper
-	// Supported values: "pytorch", "volcano"
+	// Supported values: "pytorch", "volcano", "tfjob", "mpijob", "deepspeedjob", "rayjob", "job"
 	InnerJobType string `yaml:"innerJobType,omitempty"`
 
+	// ========== TFJob specific parameters ==========
+
+	// PSCount specifies the number of parameter servers of the TFJob
+	PSCount int `yaml:"psCount,omitempty"`
+
+	// UseChief adds a Chief replica to the TFJob
+	UseChief bool `yaml:"useChief,omitempty"`
+
 	// ========== Volcano Job specific parameters ==========
 
 	// MinAvailable specifies the minimum number of pods that must be available
@@ -97,6 +109,22 @@ type SubmitAppWrapperJobArgs struct {
 	// Replicas specifies the number of replicas for Volcano Job tasks
 	Replicas int32 `yaml:"replicas,omitempty"`
 
+	// Tasks defines the tasks of a multi-task Volcano Job (e.g. master/worker).
+	// If empty, a single task is built from TaskName, Replicas and GPUCount
+	Tasks []AppWrapperTaskArgs `yaml:"tasks,omitempty"`
+
+	// VolcanoQueue specifies the Volcano queue of the job, used by Volcano for fair share.
+	// It is independent of the Kueue LocalQueue
+	VolcanoQueue string `yaml:"volcanoQueue,omitempty"`
+
+	// Policies defines the lifecycle policies of the Volcano Job, policies with a task
+	// name are set on that task instead of the job
+	Policies []VolcanoPolicyArgs `yaml:"policies,omitempty"`
+
+	// Plugins specifies the extra Volcano job plugins, supported values: ssh, env.
+	// The svc plugin is controlled by UseSvcPlugin
+	Plugins []string `yaml:"plugins,omitempty"`
+
 	// MasterPort specifies the port for distributed training communication
 	// Default is 23456
 	MasterPort int32 `yaml:"masterPort,omitempty"`
@@ -135,4 +163,53 @@ type SubmitAppWrapperJobArgs struct {
 	// RingController specifies the ring controller label for hardware affinity
 	// e.g., "ascend-1980" for Huawei Ascend NPU
 	RingController string `yaml:"ringController,omitempty"`
+
+	// AcceleratorResource specifies the resource name of the accelerator requested by --npus,
+	// e.g. "huawei.com/Ascend910". It replaces nvidia.com/gpu in the resource limits
+	AcceleratorResource string `yaml:"acceleratorResource,omitempty"`
+
+	// AcceleratorCount specifies the number of accelerators of each replica
+	AcceleratorCount int `yaml:"acceleratorCount,omitempty"`
+
+	// RankTable publishes the Ascend rank table of the job as a ConfigMap mounted into
+	// every pod, RANK_TABLE_FILE and the HCCL env are set to match
+	RankTable bool `yaml:"rankTable,omitempty"`
+}
+
+// VolcanoPolicyArgs defines a lifecycle policy of a Volcano Job or task,
+// e.g. PodEvicted -> RestartJob
+type VolcanoPolicyArgs struct {
+	// Event triggers the action, e.g. PodEvicted, only one of Event and ExitCode is set
+	Event string `yaml:"event,omitempty"`
+	// ExitCode triggers the action if a container exits with it
+	ExitCode int32 `yaml:"exitCode,omitempty"`
+	// Action is the action taken by the Volcano controller, e.g. RestartJob
+	Action string `yaml:"action"`
+	// Timeout is the grace period before taking the action, e.g. 10m
+	Timeout string `yaml:"timeout,omitempty"`
+	// Task is the task name of a task level policy, empty for a job level policy
+	Task string `yaml:"task,omitempty"`
+}
+
+// AppWrapperTaskArgs defines one task of a multi-task Volcano Job inside AppWrapper
+type AppWrapperTaskArgs struct {
+	// Name is the task name, pods are named <job>-<name>-<index>
+	Name string `yaml:"name"`
+	// Replicas is the number of pods of the task
+	Replicas int32 `yaml:"replicas"`
+	// GPUCount is the number of gpus of each pod, defaults to --gpus
+	GPUCount int `yaml:"gpuCount"`
+	// MinAvailable is the minimum number of pods of the task for gang scheduling
+	MinAvailable int32 `yaml:"minAvailable,omitempty"`
+	// Cpu overrides --cpu for the pods of the task
+	Cpu string `yaml:"cpu,omitempty"`
+	// Memory overrides --memory for the pods of the task
+	Memory string `yaml:"memory,omitempty"`
+	// Devices overrides --device for the pods of the task, e.g. rdma/hca: 1
+	Devices map[string]string `yaml:"devices,omitempty"`
+	// NprocPerNode overrides --nproc-per-node for the pods of the task
+	NprocPerNode string `yaml:"nprocPerNode,omitempty"`
+	// ProcessGroup tells whether the task counts in WORLD_SIZE and the ranks,
+	// defaults to true unless the task has no gpus while other tasks have
+	ProcessGroup *bool `yaml:"processGroup,omitempty"`
 }
@@ -28,6 +28,8 @@ type SubmitSparkJobArgs struct {
 	Annotations map[string]string `yaml:"annotations"`
 	// Labels specify the job labels and it is work for pods
 	Labels map[string]string `yaml:"labels"`
+	// DryRun only prints the rendered manifests instead of submitting the job,match option --dry-run
+	DryRun DryRunStrategy `yaml:"-"`
 }
 
 type Driver struct {
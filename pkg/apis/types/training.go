@@ -14,7 +14,10 @@
 
 package types
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // TrainingJobType defines the supporting training job type
 type TrainingJobType string
@@ -55,6 +58,8 @@ type TrainingJobTypeInfo struct {
 var (
 	ErrTrainingJobNotFound      = errors.New("training job not found,please use 'arena list' to make sure job is existed")
 	ErrNoPrivilegesToOperateJob = errors.New("you have no privileges to operate the job,because the owner of job is not you")
+	ErrWaitTimeout              = errors.New("timed out waiting for the training job")
+	ErrWaitConditionUnreachable = errors.New("the training job is finished and will never reach the expected state")
 )
 
 // ServingTypeMap collects serving job type and their alias
@@ -121,6 +126,9 @@ type TrainingJobInfo struct {
 	Namespace string `json:"namespace" yaml:"namespace"`
 	// The time of the training job
 	Duration string `json:"duration" yaml:"duration"`
+	// LastAdmittedAfter is the time from the creation of the job until its last admission,
+	// only set for jobs admitted by a queue
+	LastAdmittedAfter string `json:"lastAdmittedAfter,omitempty" yaml:"lastAdmittedAfter,omitempty"`
 	// The status of the training Job
 	Status TrainingJobStatus `json:"status" yaml:"status"`
 
@@ -144,6 +152,19 @@ type TrainingJobInfo struct {
 	// AllocatedGPU stores the allocated gpus
 	AllocatedGPU int64 `json:"allocatedGPUs" yaml:"allocatedGPUs"`
 
+	// Accelerator stores the accelerator resource name of the gpus, e.g. nvidia.com/gpu or huawei.com/Ascend910
+	Accelerator string `json:"accelerator,omitempty" yaml:"accelerator,omitempty"`
+
+	// Kueue stores the Kueue admission state of the job, only set for jobs queued by Kueue
+	Kueue *KueueWorkloadInfo `json:"kueue,omitempty" yaml:"kueue,omitempty"`
+
+	// PodGroups stores the gang scheduling state of the job, only set for volcano jobs and appwrapper jobs scheduled by volcano,
+	// an appwrapper has one PodGroup for each volcano job it wraps
+	PodGroups []*PodGroupInfo `json:"podGroups,omitempty" yaml:"podGroups,omitempty"`
+
+	// AppWrapper stores the condition timeline and retry history, only set for appwrapper jobs
+	AppWrapper *AppWrapperStatusInfo `json:"appwrapper,omitempty" yaml:"appwrapper,omitempty"`
+
 	// CreationTimestamp stores the creation timestamp of job
 	CreationTimestamp int64 `json:"creationTimestamp" yaml:"creationTimestamp"`
 
@@ -171,6 +192,55 @@ const (
 	TrainingJobSuspended TrainingJobStatus = "SUSPENDED"
 )
 
+// TrainingJobPredicate reports whether the training job reaches the expected state
+type TrainingJobPredicate func(job *TrainingJobInfo) bool
+
+// TrainingJobStatusIn returns the predicate that the status of the training job is one of the given statuses
+func TrainingJobStatusIn(statuses ...TrainingJobStatus) TrainingJobPredicate {
+	return func(job *TrainingJobInfo) bool {
+		for _, status := range statuses {
+			if job.Status == status {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// PruneArgs selects the training jobs to prune
+type PruneArgs struct {
+	// AllNamespaces prunes the training jobs in all the namespaces
+	AllNamespaces bool
+	// Since prunes the training jobs which live longer than it, -1 means not set
+	Since time.Duration
+	// Statuses limits the training jobs to the statuses, all the not running jobs are pruned if it is empty
+	Statuses []TrainingJobStatus
+	// JobType limits the training jobs to the type
+	JobType TrainingJobType
+	// LabelSelector limits the training jobs to the labels, e.g. team=nlp
+	LabelSelector string
+	// User limits the training jobs to the ones submitted by the user
+	User string
+	// KeepLast keeps the latest N training jobs of every user
+	KeepLast int
+	// DryRun only returns the training jobs to prune without deleting them
+	DryRun bool
+}
+
+// PrunedTrainingJob is a training job which is pruned or would be pruned in dry run
+type PrunedTrainingJob struct {
+	// Name is the name of the training job
+	Name string `json:"name" yaml:"name"`
+	// Namespace is the namespace of the training job
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Type is the type of the training job
+	Type TrainingJobType `json:"type" yaml:"type"`
+	// Status is the status of the training job when it is pruned
+	Status TrainingJobStatus `json:"status" yaml:"status"`
+	// Age is the age of the training job when it is pruned
+	Age string `json:"age" yaml:"age"`
+}
+
 // TrainingJobInstance defines the instance of training job
 type TrainingJobInstance struct {
 	// IP defines the instance ip
@@ -195,6 +265,107 @@ type TrainingJobInstance struct {
 	CreationTimestamp int64 `json:"creationTimestamp" yaml:"creationTimestamp"`
 }
 
+// TrainingJobCondition stores a condition of the training job
+type TrainingJobCondition struct {
+	// Type is the type of the condition, e.g. PodsReady
+	Type string `json:"type" yaml:"type"`
+	// Status is the status of the condition, one of True, False, Unknown
+	Status string `json:"status" yaml:"status"`
+	// Reason is the machine readable reason of the last transition
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	// Message is the human readable message of the last transition
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+	// LastTransitionTime is the unix timestamp of the last transition
+	LastTransitionTime int64 `json:"lastTransitionTime" yaml:"lastTransitionTime"`
+}
+
+// AppWrapperStatusInfo stores the status details of an appwrapper job
+type AppWrapperStatusInfo struct {
+	// Phase is the raw phase of the AppWrapper, e.g. Resetting
+	Phase string `json:"phase" yaml:"phase"`
+	// Retries is the number of times the AppWrapper has been reset
+	Retries int32 `json:"retries" yaml:"retries"`
+	// RetryLimit is the maximum number of retries before the AppWrapper is marked as Failed
+	RetryLimit int32 `json:"retryLimit" yaml:"retryLimit"`
+	// AdmissionTime is the unix timestamp quota was last reserved, 0 if the job is queuing
+	AdmissionTime int64 `json:"admissionTime,omitempty" yaml:"admissionTime,omitempty"`
+	// RunStartTime is the unix timestamp all pods became ready, 0 if the job never ran
+	RunStartTime int64 `json:"runStartTime,omitempty" yaml:"runStartTime,omitempty"`
+	// FinishTime is the unix timestamp the job succeeded or failed, 0 if it is not finished
+	FinishTime int64 `json:"finishTime,omitempty" yaml:"finishTime,omitempty"`
+	// Conditions is the condition timeline ordered by transition time
+	Conditions []TrainingJobCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	// Components stores the status of the inner components
+	Components []AppWrapperComponentInfo `json:"components,omitempty" yaml:"components,omitempty"`
+	// RankTable stores the Ascend rank table built from the device annotations of the pods,
+	// nil if the job does not publish a rank table
+	RankTable *AscendRankTableInfo `json:"rankTable,omitempty" yaml:"rankTable,omitempty"`
+}
+
+// AscendRankTableInfo stores the Ascend rank table of an appwrapper job
+type AscendRankTableInfo struct {
+	// ConfigMap is the name of the ConfigMap the rank table is published in
+	ConfigMap string `json:"configMap" yaml:"configMap"`
+	// Status is completed when the devices of all expected servers are known, else initializing
+	Status string `json:"status" yaml:"status"`
+	// ExpectedServers is the number of pods requesting Ascend NPUs
+	ExpectedServers int32 `json:"expectedServers" yaml:"expectedServers"`
+	// Servers are the pods whose devices are known, ordered by rank
+	Servers []AscendRankTableServerInfo `json:"servers,omitempty" yaml:"servers,omitempty"`
+}
+
+// AscendRankTableServerInfo stores the devices of a pod in the Ascend rank table
+type AscendRankTableServerInfo struct {
+	// Pod is the name of the pod
+	Pod string `json:"pod" yaml:"pod"`
+	// ServerID is the host IP of the pod
+	ServerID string `json:"serverId" yaml:"serverId"`
+	// FirstRank is the rank id of the first device of the pod
+	FirstRank int `json:"firstRank" yaml:"firstRank"`
+	// Devices is the number of devices of the pod
+	Devices int `json:"devices" yaml:"devices"`
+}
+
+// AppWrapperComponentInfo stores the status of an inner component of an appwrapper job
+type AppWrapperComponentInfo struct {
+	// Name is the name of the component resource
+	Name string `json:"name" yaml:"name"`
+	// Kind is the kind of the component resource, e.g. Job
+	Kind string `json:"kind" yaml:"kind"`
+	// APIVersion is the api version of the component resource
+	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
+	// Conditions are the conditions of the component
+	Conditions []TrainingJobCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	// CleanPodPolicy is the clean pod policy of a PyTorchJob component
+	CleanPodPolicy string `json:"cleanPodPolicy,omitempty" yaml:"cleanPodPolicy,omitempty"`
+	// ExpectedPods is the number of pods declared in the podSets of the component
+	ExpectedPods int32 `json:"expectedPods" yaml:"expectedPods"`
+	// Replicas stores the running pods of each role declared in the component spec
+	Replicas []AppWrapperReplicaInfo `json:"replicas,omitempty" yaml:"replicas,omitempty"`
+}
+
+// AppWrapperReplicaInfo stores the running pods of a role, e.g. a PyTorch replica type or a Volcano task
+type AppWrapperReplicaInfo struct {
+	// Role is the PyTorch replica type or the Volcano task name
+	Role string `json:"role" yaml:"role"`
+	// Expected is the number of replicas declared in the spec
+	Expected int32 `json:"expected" yaml:"expected"`
+	// Running is the number of running pods
+	Running int32 `json:"running" yaml:"running"`
+}
+
+// ResubmitArgs defines the args to resubmit a training job from its stored values
+type ResubmitArgs struct {
+	// Name is the name of the new job, the old job is replaced if it is empty or the same
+	Name string
+	// Replace deletes the old job before the job is resubmitted with the same name
+	Replace bool
+	// Sets are the overrides of the stored values, like "learningRate=0.01"
+	Sets []string
+	// DryRun renders the manifests of the job without creating them
+	DryRun DryRunStrategy
+}
+
 const (
 	RequestGPUsOfJobAnnoKey = "requestGPUsOfJobOwner"
 )
@@ -33,12 +33,26 @@ const (
 	UnknownFormat FormatStyle = "unknown"
 )
 
+// DryRunStrategy defines how a job is submitted in dry run mode
+type DryRunStrategy string
+
+const (
+	// DryRunNone submits the job
+	DryRunNone DryRunStrategy = ""
+	// DryRunClient only renders the manifests of the job
+	DryRunClient DryRunStrategy = "client"
+	// DryRunServer renders the manifests and validates them by a server side dry run
+	DryRunServer DryRunStrategy = "server"
+)
+
 type ArenaClientArgs struct {
 	Kubeconfig     string
 	Namespace      string
 	ArenaNamespace string
 	IsDaemonMode   bool
-	LogLevel       string
+	// DaemonNamespaced limits the cache of the daemon mode to the namespace of the client
+	DaemonNamespaced bool
+	LogLevel         string
 }
 
 type K8sObject struct {
@@ -0,0 +1,48 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// AcceleratorInContainer returns the accelerator resource name and count in the container limits,
+// the first of the given accelerator resources found in the container wins
+func AcceleratorInContainer(container corev1.Container, resourceNames []string) (string, int64) {
+	for _, name := range resourceNames {
+		val, ok := container.Resources.Limits[corev1.ResourceName(name)]
+		if ok && val.Value() != 0 {
+			return name, val.Value()
+		}
+	}
+	return "", 0
+}
+
+// AcceleratorInPod returns the accelerator resource name and the total count of the pod
+func AcceleratorInPod(pod *corev1.Pod, resourceNames []string) (string, int) {
+	kind := ""
+	total := int64(0)
+	for _, container := range pod.Spec.Containers {
+		name, count := AcceleratorInContainer(container, resourceNames)
+		if count == 0 {
+			continue
+		}
+		if kind == "" {
+			kind = name
+		}
+		total += count
+	}
+	return kind, int(total)
+}
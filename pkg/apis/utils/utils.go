@@ -16,6 +16,7 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"os"
@@ -194,6 +195,19 @@ func PrintErrorMessage(message string) {
 	log.Errorf("%v", message)
 }
 
+// ExitCode returns the exit code of the command error, arena wait exits with 124 on timeout like
+// the timeout command and with 2 when the training job finished in an unexpected state
+func ExitCode(err error) int {
+	switch {
+	case errors.Is(err, types.ErrWaitTimeout):
+		return 124
+	case errors.Is(err, types.ErrWaitConditionUnreachable):
+		return 2
+	default:
+		return 1
+	}
+}
+
 func DefineNodeStatus(node *corev1.Node) string {
 	conditionMap := make(map[corev1.NodeConditionType]*corev1.NodeCondition)
 	NodeAllConditions := []corev1.NodeConditionType{corev1.NodeReady}
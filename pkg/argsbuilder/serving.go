@@ -90,6 +90,7 @@ func (s *ServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
 		configFiles        []string
 		imagePullSecrets   []string
 		devices            []string
+		dryRun             string
 	)
 	defaultImage := ""
 	item, ok := s.argValues["default-image"]
@@ -156,6 +157,8 @@ func (s *ServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().StringVar(&s.args.ModelName, "model-name", "", "model name")
 	// add option --model-version
 	command.Flags().StringVar(&s.args.ModelVersion, "model-version", "", "model version")
+	// add option --dry-run
+	AddDryRunFlag(command, &dryRun)
 
 	s.AddArgValue("annotation", &annotations).
 		AddArgValue("toleration", &tolerations).
@@ -170,7 +173,8 @@ func (s *ServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
 		AddArgValue("device", &devices).
 		AddArgValue("env-from-secret", &envsFromSecret).
 		AddArgValue("config-file", &configFiles).
-		AddArgValue("image-pull-secret", &imagePullSecrets)
+		AddArgValue("image-pull-secret", &imagePullSecrets).
+		AddArgValue("dry-run", &dryRun)
 }
 
 func (s *ServingArgsBuilder) PreBuild() error {
@@ -182,6 +186,9 @@ func (s *ServingArgsBuilder) PreBuild() error {
 	if err := s.checkNamespace(); err != nil {
 		return err
 	}
+	if err := s.setDryRun(); err != nil {
+		return err
+	}
 	if err := s.validateIstioEnablement(); err != nil {
 		return err
 	}
@@ -452,6 +459,20 @@ func (s *ServingArgsBuilder) setLabels() error {
 	return nil
 }
 
+// setDryRun is used to handle option --dry-run
+func (s *ServingArgsBuilder) setDryRun() error {
+	item, ok := s.argValues["dry-run"]
+	if !ok {
+		return nil
+	}
+	strategy, err := ParseDryRunStrategy(*item.(*string))
+	if err != nil {
+		return err
+	}
+	s.args.DryRun = strategy
+	return nil
+}
+
 // setDevices is used to handle option --device
 func (s *ServingArgsBuilder) setDevices() error {
 	s.args.Devices = map[string]string{}
@@ -0,0 +1,274 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// specNameKey is the key of the job name in a spec file, the name is not a part of the chart values
+const specNameKey = "name"
+
+// BuilderKeys are the chart values set by the builders themselves, they are neither in the schema
+// nor accepted in a spec file
+var BuilderKeys = []string{"trainingType", "podGroupName", "useChief", "usechief"}
+
+// addSpecFileFlags adds option -f and --print-schema of the submit commands, it must be invoked
+// after all flags are added since the required flags are only required without a spec file
+func addSpecFileFlags(command *cobra.Command, s ArgsBuilder) {
+	var (
+		specFile      string
+		requiredFlags []string
+	)
+	command.Flags().VisitAll(func(flag *pflag.Flag) {
+		if _, ok := flag.Annotations[cobra.BashCompOneRequiredFlag]; ok {
+			delete(flag.Annotations, cobra.BashCompOneRequiredFlag)
+			requiredFlags = append(requiredFlags, flag.Name)
+		}
+	})
+	command.Flags().StringVarP(&specFile, "filename", "f", "", `the spec file of the job, its keys are the same as "--print-schema" and the flags given in the command line override them`)
+	command.Flags().Bool("print-schema", false, "print the JSON schema of the spec file of the job and exit")
+	s.AddArgValue("filename", &specFile).
+		AddArgValue("flags", command.Flags()).
+		AddArgValue("required-flags", requiredFlags)
+}
+
+// loadSpecFile loads the args of the job from the spec file of option -f, the flags changed in
+// the command line are set again afterwards so that they override the values of the file
+func loadSpecFile(args interface{}, name *string, argValues map[string]interface{}) error {
+	var flags *pflag.FlagSet
+	if item, ok := argValues["flags"]; ok {
+		flags = item.(*pflag.FlagSet)
+	}
+	specFile := ""
+	if item, ok := argValues["filename"]; ok {
+		specFile = *item.(*string)
+	}
+	if specFile == "" {
+		return checkRequiredFlags(flags, argValues)
+	}
+	dropDefaultArgValues(argValues)
+	content, err := os.ReadFile(specFile)
+	if err != nil {
+		return fmt.Errorf("failed to read spec file %s: %v", specFile, err)
+	}
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return fmt.Errorf("failed to parse spec file %s: %v", specFile, err)
+	}
+	specName, err := removeSpecName(&document)
+	if err != nil {
+		return fmt.Errorf("invalid spec file %s: %v", specFile, err)
+	}
+	if err := checkBuilderKeys(&document); err != nil {
+		return fmt.Errorf("invalid spec file %s: %v", specFile, err)
+	}
+	values, err := yaml.Marshal(&document)
+	if err != nil {
+		return err
+	}
+
+	// record the flags of the command line before the file overrides their targets
+	changed := map[*pflag.Flag]interface{}{}
+	if flags != nil {
+		flags.Visit(func(flag *pflag.Flag) {
+			if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+				changed[flag] = append([]string{}, sliceValue.GetSlice()...)
+				return
+			}
+			changed[flag] = flag.Value.String()
+		})
+	}
+
+	if len(document.Content) > 0 {
+		decoder := yaml.NewDecoder(bytes.NewReader(values))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(args); err != nil {
+			return fmt.Errorf("invalid spec file %s: %v", specFile, err)
+		}
+	}
+	if specName != "" {
+		*name = specName
+	}
+
+	for flag, value := range changed {
+		if slice, ok := value.([]string); ok {
+			err = flag.Value.(pflag.SliceValue).Replace(slice)
+		} else {
+			err = flag.Value.Set(value.(string))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to override spec file with option --%s: %v", flag.Name, err)
+		}
+	}
+	log.Debugf("loaded spec file %s", specFile)
+	return nil
+}
+
+// dropDefaultArgValues removes the arg values of the options not given in the command line when the
+// job is loaded from a spec file. The builders only convert the options found in their arg values, so
+// the defaults of the other options never override the values of the file. Every builder holding the
+// options converted in Build must invoke it on its own arg values before converting them
+func dropDefaultArgValues(argValues map[string]interface{}) {
+	item, ok := argValues["filename"]
+	if !ok || *item.(*string) == "" {
+		return
+	}
+	flags, ok := argValues["flags"]
+	if !ok {
+		return
+	}
+	flags.(*pflag.FlagSet).VisitAll(func(flag *pflag.Flag) {
+		if !flag.Changed {
+			delete(argValues, flag.Name)
+		}
+	})
+}
+
+// checkRequiredFlags checks the required flags are set when the job is not loaded from a spec file
+func checkRequiredFlags(flags *pflag.FlagSet, argValues map[string]interface{}) error {
+	item, ok := argValues["required-flags"]
+	if !ok || flags == nil {
+		return nil
+	}
+	missing := []string{}
+	for _, name := range item.([]string) {
+		if !flags.Changed(name) {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf(`required flag(s) "%s" not set`, strings.Join(missing, `", "`))
+	}
+	return nil
+}
+
+// removeSpecName removes the job name from the spec document and returns it
+func removeSpecName(document *yaml.Node) (string, error) {
+	if len(document.Content) == 0 {
+		return "", nil
+	}
+	mapping := document.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return "", fmt.Errorf("the spec must be a mapping")
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != specNameKey {
+			continue
+		}
+		name := mapping.Content[i+1].Value
+		mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+		return name, nil
+	}
+	return "", nil
+}
+
+// checkBuilderKeys checks the spec document does not set the chart values set by the builders
+func checkBuilderKeys(document *yaml.Node) error {
+	if len(document.Content) == 0 {
+		return nil
+	}
+	mapping := document.Content[0]
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		for _, key := range BuilderKeys {
+			if mapping.Content[i].Value == key {
+				return fmt.Errorf("%s is set by arena and can not be set in the spec", key)
+			}
+		}
+	}
+	return nil
+}
+
+// SubmitArgsSchema returns the JSON schema of the spec file of a job whose args are the given struct
+func SubmitArgsSchema(title string, args interface{}) ([]byte, error) {
+	schema := schemaOf(reflect.TypeOf(args))
+	schema["$schema"] = "http://json-schema.org/draft-07/schema#"
+	schema["title"] = title
+	properties := schema["properties"].(map[string]interface{})
+	for _, key := range BuilderKeys {
+		delete(properties, key)
+	}
+	if _, found := properties[specNameKey]; !found {
+		properties[specNameKey] = map[string]interface{}{
+			"type":        "string",
+			"description": "the job name, overridden by option --name",
+		}
+	}
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// schemaOf returns the JSON schema of a type as it is decoded from yaml
+func schemaOf(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": schemaOf(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": schemaOf(t.Elem())}
+	case reflect.Struct:
+		properties := map[string]interface{}{}
+		addStructProperties(t, properties)
+		return map[string]interface{}{"type": "object", "properties": properties, "additionalProperties": false}
+	}
+	return map[string]interface{}{}
+}
+
+// addStructProperties adds the yaml fields of a struct to the properties, inline fields are flattened
+func addStructProperties(t reflect.Type, properties map[string]interface{}) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("yaml")
+		if tag == "-" {
+			continue
+		}
+		key, options, _ := strings.Cut(tag, ",")
+		if strings.Contains(options, "inline") {
+			inline := field.Type
+			for inline.Kind() == reflect.Ptr {
+				inline = inline.Elem()
+			}
+			addStructProperties(inline, properties)
+			continue
+		}
+		if key == "" {
+			key = strings.ToLower(field.Name)
+		}
+		properties[key] = schemaOf(field.Type)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+)
+
+// initTestArenaConfiger initializes the arena configer against a stub api server which
+// only knows the default namespace and the test user, Build reads the user from the configer
+func initTestArenaConfiger(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/namespaces/default":
+			fmt.Fprint(w, `{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"default"}}`)
+			return
+		case "/apis/authentication.k8s.io/v1/tokenreviews":
+			fmt.Fprint(w, `{"kind":"TokenReview","apiVersion":"authentication.k8s.io/v1","status":{"authenticated":true,"user":{"username":"test"}}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+	}))
+	t.Cleanup(server.Close)
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	content := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+    insecure-skip-tls-verify: true
+users:
+- name: test
+  user:
+    token: test
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+    namespace: default
+current-context: test
+`, server.URL)
+	if err := os.WriteFile(kubeconfig, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := config.InitArenaConfiger(types.ArenaClientArgs{Kubeconfig: kubeconfig, Namespace: "default", ArenaNamespace: "arena-system"}); err != nil {
+		t.Fatalf("failed to init the arena configer: %v", err)
+	}
+}
+
+func TestSpecFileSurvivesBuild(t *testing.T) {
+	initTestArenaConfiger(t)
+	dir := t.TempDir()
+	hostFile := filepath.Join(dir, "train.conf")
+	if err := os.WriteFile(hostFile, []byte("lr: 0.1"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cliFile := filepath.Join(dir, "cli.conf")
+	if err := os.WriteFile(cliFile, []byte("lr: 0.2"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	specFile := filepath.Join(dir, "job.yaml")
+	spec := fmt.Sprintf(`name: spec-job
+image: tensorflow/tensorflow:2.0
+workers: 2
+workerPort: 2222
+activeDeadlineSeconds: 600
+nodeSelectors:
+  pool: gpu
+tfNodeSelectors:
+  Worker:
+    zone: a
+tolerations:
+- key: dedicated
+  operator: Exists
+configFiles:
+  spec:
+    config-0:
+      key: config-0
+      hostFile: %s
+      containerFileName: train.conf
+      containerFilePath: /etc/train
+`, hostFile)
+	if err := os.WriteFile(specFile, []byte(spec), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	build := func(flags ...string) *types.SubmitTFJobArgs {
+		args := &types.SubmitTFJobArgs{}
+		builder := NewSubmitTFJobArgsBuilder(args)
+		command := &cobra.Command{Use: "tfjob"}
+		builder.AddCommandFlags(command)
+		if err := command.ParseFlags(append([]string{"-f", specFile}, flags...)); err != nil {
+			t.Fatalf("failed to parse %v: %v", flags, err)
+		}
+		if err := builder.PreBuild(); err != nil {
+			t.Fatalf("failed to pre build %v: %v", flags, err)
+		}
+		if err := builder.Build(); err != nil {
+			t.Fatalf("failed to build %v: %v", flags, err)
+		}
+		return args
+	}
+
+	args := build()
+	if args.Name != "spec-job" || args.WorkerCount != 2 {
+		t.Errorf("expected the name and workers of the spec file, got %v and %v", args.Name, args.WorkerCount)
+	}
+	if expected := map[string]string{"pool": "gpu"}; !reflect.DeepEqual(args.NodeSelectors, expected) {
+		t.Errorf("expected the node selectors of the spec file %v, got %v", expected, args.NodeSelectors)
+	}
+	if expected := map[string]string{"zone": "a"}; !reflect.DeepEqual(args.TFNodeSelectors["Worker"], expected) {
+		t.Errorf("expected the worker selectors of the spec file %v, got %v", expected, args.TFNodeSelectors["Worker"])
+	}
+	if expected := map[string]string{"pool": "gpu"}; !reflect.DeepEqual(args.TFNodeSelectors["PS"], expected) {
+		t.Errorf("expected the ps selectors to default to the node selectors %v, got %v", expected, args.TFNodeSelectors["PS"])
+	}
+	if len(args.Tolerations) != 1 || args.Tolerations[0].Key != "dedicated" {
+		t.Errorf("expected the tolerations of the spec file, got %+v", args.Tolerations)
+	}
+	if args.ActiveDeadlineSeconds != 600 {
+		t.Errorf("expected the active deadline of the spec file, got %v", args.ActiveDeadlineSeconds)
+	}
+	if info := args.ConfigFiles["spec"]["config-0"]; info.HostFile != hostFile {
+		t.Errorf("expected the config files of the spec file, got %+v", args.ConfigFiles)
+	}
+	if expected := "--set-file configFiles.spec.config-0.content=" + hostFile; !util.StringInSlice(expected, args.HelmOptions) {
+		t.Errorf("expected the helm option %q of the spec config file, got %v", expected, args.HelmOptions)
+	}
+
+	args = build("--name", "cli-job", "--workers", "3", "--selector", "pool=cpu", "--worker-selector", "zone=b",
+		"--toleration", "gpu", "--config-file", cliFile+":/etc/cli/cli.conf", "--running-timeout", "1m")
+	if args.Name != "cli-job" || args.WorkerCount != 3 {
+		t.Errorf("expected the name and workers of the flags, got %v and %v", args.Name, args.WorkerCount)
+	}
+	if expected := map[string]string{"pool": "cpu"}; !reflect.DeepEqual(args.NodeSelectors, expected) {
+		t.Errorf("expected the node selectors of --selector %v, got %v", expected, args.NodeSelectors)
+	}
+	if expected := map[string]string{"zone": "b"}; !reflect.DeepEqual(args.TFNodeSelectors["Worker"], expected) {
+		t.Errorf("expected the worker selectors of --worker-selector %v, got %v", expected, args.TFNodeSelectors["Worker"])
+	}
+	if len(args.Tolerations) != 1 || args.Tolerations[0].Key != "gpu" {
+		t.Errorf("expected --toleration to replace the tolerations of the spec file, got %+v", args.Tolerations)
+	}
+	if args.ActiveDeadlineSeconds != 60 {
+		t.Errorf("expected the active deadline of --running-timeout, got %v", args.ActiveDeadlineSeconds)
+	}
+	if _, found := args.ConfigFiles["spec"]; found || len(args.ConfigFiles) != 1 {
+		t.Errorf("expected --config-file to replace the config files of the spec file, got %+v", args.ConfigFiles)
+	}
+}
+
+func TestSpecFileBuilderKeys(t *testing.T) {
+	schema, err := SubmitArgsSchema("tfjob spec", types.SubmitTFJobArgs{})
+	if err != nil {
+		t.Fatalf("failed to build the schema: %v", err)
+	}
+	var document struct {
+		Properties map[string]interface{} `json:"properties"`
+	}
+	if err := json.Unmarshal(schema, &document); err != nil {
+		t.Fatalf("failed to parse the schema: %v", err)
+	}
+	for _, key := range append(BuilderKeys, "image", specNameKey) {
+		_, found := document.Properties[key]
+		if expected := !util.StringInSlice(key, BuilderKeys); found != expected {
+			t.Errorf("expected key %v in the schema to be %v, got %v", key, expected, found)
+		}
+	}
+
+	specFile := filepath.Join(t.TempDir(), "job.yaml")
+	if err := os.WriteFile(specFile, []byte("image: tensorflow/tensorflow:2.0\ntrainingType: mpijob\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	args := &types.SubmitTFJobArgs{}
+	name := ""
+	if err := loadSpecFile(args, &name, map[string]interface{}{"filename": &specFile}); err == nil {
+		t.Errorf("expected the spec file setting trainingType to be invalid")
+	}
+}
@@ -81,6 +81,7 @@ func (s *SubmitArgsBuilder) AddCommandFlags(command *cobra.Command) {
 		configFiles      []string
 		imagePullSecrets []string
 		devices          []string
+		dryRun           string
 	)
 	// create subcommands
 	// add option --name
@@ -153,6 +154,8 @@ func (s *SubmitArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().StringVar(&s.args.ModelName, "model-name", "", "model name")
 	// add option --model-source
 	command.Flags().StringVar(&s.args.ModelSource, "model-source", "", "model source is a URI indicating the location of the model e.g. s3://my-bucket/path/to/model, pvc://namespace/pvc-name/path/to/model")
+	// add option --dry-run
+	AddDryRunFlag(command, &dryRun)
 
 	s.AddArgValue("image-pull-secret", &imagePullSecrets).
 		AddArgValue("config-file", &configFiles).
@@ -163,10 +166,12 @@ func (s *SubmitArgsBuilder) AddCommandFlags(command *cobra.Command) {
 		AddArgValue("data", &dataSet).
 		AddArgValue("label", &labels).
 		AddArgValue("env", &envs).
-		AddArgValue("device", &devices)
+		AddArgValue("device", &devices).
+		AddArgValue("dry-run", &dryRun)
 }
 
 func (s *SubmitArgsBuilder) PreBuild() error {
+	dropDefaultArgValues(s.argValues)
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
@@ -184,6 +189,9 @@ func (s *SubmitArgsBuilder) PreBuild() error {
 	if err := s.checkModelNameAndSource(); err != nil {
 		return err
 	}
+	if err := s.setDryRun(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -282,13 +290,13 @@ func (s *SubmitArgsBuilder) checkNameAndPriorityClassName() error {
 
 // setDataDirs is used to handle option --data-dir
 func (s *SubmitArgsBuilder) setDataDirs() error {
-	s.args.DataDirs = []types.DataDirVolume{}
 	argKey := "data-dir"
 	var dataDirs *[]string
 	value, ok := s.argValues[argKey]
 	if !ok {
 		return nil
 	}
+	s.args.DataDirs = []types.DataDirVolume{}
 	dataDirs = value.(*[]string)
 	log.Debugf("dataDir: %v", *dataDirs)
 	for i, dataDir := range *dataDirs {
@@ -307,13 +315,13 @@ func (s *SubmitArgsBuilder) setDataDirs() error {
 
 // setDataSets is used to handle option --data
 func (s *SubmitArgsBuilder) setDataSet() error {
-	s.args.DataSet = map[string]string{}
 	argKey := "data"
 	var dataSet *[]string
 	value, ok := s.argValues[argKey]
 	if !ok {
 		return nil
 	}
+	s.args.DataSet = map[string]string{}
 	dataSet = value.(*[]string)
 	log.Debugf("dataset: %v", *dataSet)
 	if len(*dataSet) <= 0 {
@@ -366,6 +374,20 @@ func (s *SubmitArgsBuilder) setQueue() error {
 	return nil
 }
 
+// setDryRun is used to handle option --dry-run
+func (s *SubmitArgsBuilder) setDryRun() error {
+	item, ok := s.argValues["dry-run"]
+	if !ok {
+		return nil
+	}
+	strategy, err := ParseDryRunStrategy(*item.(*string))
+	if err != nil {
+		return err
+	}
+	s.args.DryRun = strategy
+	return nil
+}
+
 // setDevices is used to handle option --device
 func (s *SubmitArgsBuilder) setDevices() error {
 	if s.args.Devices == nil {
@@ -481,19 +503,30 @@ func (s *SubmitArgsBuilder) setNodeSelectors() error {
 
 // setConfigFiles is used to handle option --config-file
 func (s *SubmitArgsBuilder) setConfigFiles() error {
-	s.args.ConfigFiles = map[string]map[string]types.ConfigFileInfo{}
 	if s.args.HelmOptions == nil {
 		s.args.HelmOptions = []string{}
 	}
 	argKey := "config-file"
-	var configFiles *[]string
-	value, ok := s.argValues[argKey]
-	if !ok {
-		return nil
+	if value, ok := s.argValues[argKey]; ok {
+		s.args.ConfigFiles = map[string]map[string]types.ConfigFileInfo{}
+		if err := s.parseConfigFiles(*value.(*[]string)); err != nil {
+			return err
+		}
 	}
-	configFiles = value.(*[]string)
+	// the content of the config files loaded from the spec file is set as well
+	for containerPathkey, val := range s.args.ConfigFiles {
+		for configFileKey, info := range val {
+			s.args.HelmOptions = append(s.args.HelmOptions,
+				fmt.Sprintf("--set-file configFiles.%v.%v.content=%v", containerPathkey, configFileKey, info.HostFile))
+		}
+	}
+	return nil
+}
+
+// parseConfigFiles parses the values of option --config-file into the config files
+func (s *SubmitArgsBuilder) parseConfigFiles(configFiles []string) error {
 	exists := map[string]bool{}
-	for ind, val := range *configFiles {
+	for ind, val := range configFiles {
 		var (
 			containerFile string
 			err           error
@@ -546,12 +579,6 @@ func (s *SubmitArgsBuilder) setConfigFiles() error {
 		}
 		s.args.ConfigFiles[containerPathKey][configFileKey] = info
 	}
-	for containerPathkey, val := range s.args.ConfigFiles {
-		for configFileKey, info := range val {
-			s.args.HelmOptions = append(s.args.HelmOptions,
-				fmt.Sprintf("--set-file configFiles.%v.%v.content=%v", containerPathkey, configFileKey, info.HostFile))
-		}
-	}
 	return nil
 }
 
@@ -567,6 +594,8 @@ func (s *SubmitArgsBuilder) setTolerations() error {
 		return nil
 	}
 	tolerations = value.(*[]string)
+	// the tolerations of the option replace the ones loaded from the spec file
+	s.args.Tolerations = []types.TolerationArgs{}
 	log.Debugf("tolerations: %v", *tolerations)
 	for _, taintKey := range *tolerations {
 		if taintKey == "all" {
@@ -586,23 +615,17 @@ func (s *SubmitArgsBuilder) setTolerations() error {
 
 // setImagePullSecrets is used to set
 func (s *SubmitArgsBuilder) setImagePullSecrets() error {
-	s.args.ImagePullSecrets = []string{}
 	argKey := "image-pull-secret"
-	var imagePullSecrets *[]string
-	value, ok := s.argValues[argKey]
-	if !ok {
-		return nil
+	if value, ok := s.argValues[argKey]; ok {
+		s.args.ImagePullSecrets = *value.(*[]string)
 	}
-	imagePullSecrets = value.(*[]string)
-
-	if len(*imagePullSecrets) == 0 {
+	if len(s.args.ImagePullSecrets) == 0 {
+		s.args.ImagePullSecrets = []string{}
 		arenaConfig := config.GetArenaConfiger().GetConfigsFromConfigFile()
 		if temp, found := arenaConfig["imagePullSecrets"]; found {
 			log.Debugf("imagePullSecrets load from arenaConfigs: %v", temp)
 			s.args.ImagePullSecrets = strings.Split(temp, ",")
 		}
-	} else {
-		s.args.ImagePullSecrets = *imagePullSecrets
 	}
 	log.Debugf("imagePullSecrets: %v", s.args.ImagePullSecrets)
 	return nil
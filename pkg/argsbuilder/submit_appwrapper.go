@@ -24,8 +24,25 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+)
+
+// defaultAscendRingController is the ring controller label of Ascend NPU jobs scheduled by volcano
+const defaultAscendRingController = "ascend-1980"
+
+var (
+	// volcanoPolicyEvents are the events which can trigger a Volcano lifecycle policy
+	volcanoPolicyEvents = []string{"*", "PodFailed", "PodEvicted", "Unknown", "OutOfSync", "CommandIssued", "TaskCompleted"}
+	// volcanoPolicyActions are the actions of a Volcano lifecycle policy
+	volcanoPolicyActions = []string{"AbortJob", "RestartJob", "RestartTask", "TerminateJob", "CompleteJob", "ResumeJob"}
+	// volcanoPlugins are the Volcano job plugins which can be enabled by --volcano-plugin,
+	// svc is controlled by --use-svc-plugin
+	volcanoPlugins = []string{"ssh", "env"}
+	// appWrapperInnerJobTypes are the job kinds which can be wrapped inside an AppWrapper by --inner-type
+	appWrapperInnerJobTypes = []string{"pytorch", "volcano", "tfjob", "mpijob", "deepspeedjob", "rayjob", "job"}
 )
 
 type SubmitAppWrapperJobArgsBuilder struct {
@@ -78,6 +95,8 @@ func (s *SubmitAppWrapperJobArgsBuilder) AddCommandFlags(command *cobra.Command)
 		runningTimeout   time.Duration
 		ttlAfterFinished time.Duration
 		useSvcPlugin     bool
+		tasks            []string
+		policies         []string
 	)
 
 	// Basic resource settings (inherited from PyTorch pattern)
@@ -97,7 +116,12 @@ func (s *SubmitAppWrapperJobArgsBuilder) AddCommandFlags(command *cobra.Command)
 	command.Flags().StringVar(&s.args.FailureGracePeriod, "failure-grace-period", "1m", "Duration to wait before treating a failure as permanent (e.g. '1m', '30s').")
 	command.Flags().StringVar(&s.args.RetryPausePeriod, "retry-pause-period", "90s", "Duration to pause between retries (e.g. '90s', '2m').")
 	command.Flags().StringVar(&s.args.SuccessTTL, "success-ttl", "", "Duration after which a successful AppWrapper is deleted (e.g. '1h', '24h'). If not set, not auto-deleted.")
-	command.Flags().StringVar(&s.args.InnerJobType, "inner-type", "pytorch", "The type of job wrapped inside AppWrapper. Supports 'pytorch' and 'volcano'.")
+	command.Flags().BoolVar(&s.args.Suspend, "suspend", false, "Submit the AppWrapper in suspended state, use 'arena resume' to start it later.")
+	command.Flags().StringVar(&s.args.InnerJobType, "inner-type", "pytorch", "The type of job wrapped inside AppWrapper. Supports 'pytorch', 'volcano', 'tfjob', 'mpijob', 'deepspeedjob', 'rayjob' and 'job'.")
+
+	// TFJob specific settings
+	command.Flags().IntVar(&s.args.PSCount, "ps", 0, "The number of parameter servers (TFJob).")
+	command.Flags().BoolVar(&s.args.UseChief, "chief", false, "Add a Chief replica to the TFJob (TFJob).")
 
 	// Volcano Job specific settings
 	command.Flags().Int32Var(&s.args.MinAvailable, "min-available", 0, "Minimum number of pods that must be available (Volcano). If not set, defaults to replicas.")
@@ -105,7 +129,11 @@ func (s *SubmitAppWrapperJobArgsBuilder) AddCommandFlags(command *cobra.Command)
 	command.Flags().StringVar(&s.args.TaskName, "task-name", "worker", "Name of the task in Volcano Job.")
 	command.Flags().Int32Var(&s.args.MaxRetry, "max-retry", 10000, "Maximum number of retries for a task (Volcano).")
 	command.Flags().Int32Var(&s.args.Replicas, "replicas", 1, "Number of replicas for Volcano Job tasks.")
+	command.Flags().StringArrayVar(&tasks, "task", []string{}, `Define a task of a multi-task Volcano Job, can be repeated. Keys: name, replicas, gpus (or its alias npus), min-available, cpu, memory, device (<resource>:<count>, repeatable), nproc-per-node, process-group (true or false). usage: "--task name=master,replicas=1,gpus=0 --task name=worker,replicas=8,gpus=8"`)
 	command.Flags().Int32Var(&s.args.MasterPort, "master-port", 23456, "Port for distributed training communication (Volcano).")
+	command.Flags().StringVar(&s.args.VolcanoQueue, "volcano-queue", "", "The Volcano queue of the job for fair share (Volcano), independent of --kueue-queue.")
+	command.Flags().StringArrayVar(&policies, "policy", []string{}, `Define a lifecycle policy of the Volcano Job, can be repeated. Keys: event or exit-code, action, timeout, task (for a task level policy). usage: "--policy event=PodEvicted,action=RestartJob --policy event=TaskCompleted,action=CompleteJob,task=master"`)
+	command.Flags().StringSliceVar(&s.args.Plugins, "volcano-plugin", []string{}, "Extra Volcano job plugins to enable, supports 'ssh' and 'env' (e.g. --volcano-plugin ssh,env).")
 
 	// DNS resolution settings (Volcano)
 	command.Flags().BoolVar(&useSvcPlugin, "use-svc-plugin", true, "Use Volcano svc plugin for DNS resolution (requires Volcano >= 1.8). Set to false for older Volcano versions.")
@@ -122,13 +150,22 @@ func (s *SubmitAppWrapperJobArgsBuilder) AddCommandFlags(command *cobra.Command)
 
 	// Hardware specific settings
 	command.Flags().StringVar(&s.args.RingController, "ring-controller", "", "Ring controller label for hardware affinity (e.g. 'ascend-1980').")
+	command.Flags().IntVar(&s.args.AcceleratorCount, "npus", 0, "The number of accelerators (e.g. Ascend NPUs) of each replica, can not be used with --gpus.")
+	command.Flags().StringVar(&s.args.AcceleratorResource, "accelerator-resource", "", fmt.Sprintf("The resource name of the accelerator requested by --npus, defaults to %s.", types.AscendNPUResourceName))
+	command.Flags().BoolVar(&s.args.RankTable, "rank-table", false, "Publish the Ascend rank table of the job as a ConfigMap mounted into every pod and set RANK_TABLE_FILE and the HCCL env (Volcano with Ascend NPUs).")
 
 	s.AddArgValue("running-timeout", &runningTimeout).
 		AddArgValue("ttl-after-finished", &ttlAfterFinished).
-		AddArgValue("use-svc-plugin", &useSvcPlugin)
+		AddArgValue("use-svc-plugin", &useSvcPlugin).
+		AddArgValue("task", &tasks).
+		AddArgValue("policy", &policies)
+	addSpecFileFlags(command, s)
 }
 
 func (s *SubmitAppWrapperJobArgsBuilder) PreBuild() error {
+	if err := loadSpecFile(s.args, &s.args.Name, s.argValues); err != nil {
+		return err
+	}
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
@@ -144,6 +181,15 @@ func (s *SubmitAppWrapperJobArgsBuilder) Build() error {
 			return err
 		}
 	}
+	if err := s.setAccelerator(); err != nil {
+		return err
+	}
+	if err := s.setTasks(); err != nil {
+		return err
+	}
+	if err := s.setPolicies(); err != nil {
+		return err
+	}
 	// For Volcano mode, sync Replicas to WorkerCount and update related values
 	// This must be done AFTER sub-builders run, as they set envs["workers"],
 	// PodGroupMinAvailable, and request-gpus based on WorkerCount
@@ -175,8 +221,25 @@ func (s *SubmitAppWrapperJobArgsBuilder) syncVolcanoWorkerCount() error {
 		return nil
 	}
 
-	// Sync Replicas to WorkerCount for consistent resource statistics
+	// Sync Replicas to WorkerCount for consistent resource statistics,
+	// for multi-task jobs all tasks are counted
 	s.args.WorkerCount = int(s.args.Replicas)
+	requestGPUs := s.args.WorkerCount * s.acceleratorCount()
+	minAvailable := s.args.WorkerCount
+	if len(s.args.Tasks) > 0 {
+		s.args.WorkerCount = 0
+		requestGPUs = 0
+		minAvailable = 0
+		for _, task := range s.args.Tasks {
+			s.args.WorkerCount += int(task.Replicas)
+			requestGPUs += int(task.Replicas) * task.GPUCount
+			if task.MinAvailable > 0 {
+				minAvailable += int(task.MinAvailable)
+			} else {
+				minAvailable += int(task.Replicas)
+			}
+		}
+	}
 
 	// Update envs["workers"] which was set by SubmitArgsBuilder.setJobInfoToEnv()
 	if s.args.Envs == nil {
@@ -187,18 +250,193 @@ func (s *SubmitAppWrapperJobArgsBuilder) syncVolcanoWorkerCount() error {
 	// Update PodGroupMinAvailable if coscheduling is enabled
 	// This was set by SubmitArgsBuilder.addPodGroupLabel()
 	if s.args.Coscheduling {
-		s.args.PodGroupMinAvailable = fmt.Sprintf("%v", s.args.WorkerCount)
+		s.args.PodGroupMinAvailable = fmt.Sprintf("%v", minAvailable)
 	}
 
 	// Update request-gpus annotation which was set by SubmitArgsBuilder.addRequestGPUsToAnnotation()
 	if s.args.Annotations == nil {
 		s.args.Annotations = map[string]string{}
 	}
-	s.args.Annotations[types.RequestGPUsOfJobAnnoKey] = fmt.Sprintf("%v", s.args.WorkerCount*s.args.GPUCount)
+	s.args.Annotations[types.RequestGPUsOfJobAnnoKey] = fmt.Sprintf("%v", requestGPUs)
+
+	return nil
+}
+
+// setTasks parses the --task flags into the task definitions of a multi-task Volcano Job
+func (s *SubmitAppWrapperJobArgsBuilder) setTasks() error {
+	t, ok := s.argValues["task"]
+	if !ok {
+		return nil
+	}
+	s.args.Tasks = nil
+	for _, spec := range *t.(*[]string) {
+		task, err := parseAppWrapperTask(spec, s.acceleratorCount())
+		if err != nil {
+			return err
+		}
+		s.args.Tasks = append(s.args.Tasks, task)
+	}
+	return nil
+}
+
+// parseAppWrapperTask parses a task definition like "name=worker,replicas=8,gpus=8,device=rdma/hca:1",
+// gpus (or its alias npus) defaults to the given defaultGPUs and replicas defaults to 1.
+// device can be repeated, the pods of the task use --device if it is not set
+func parseAppWrapperTask(spec string, defaultGPUs int) (types.AppWrapperTaskArgs, error) {
+	task := types.AppWrapperTaskArgs{
+		Replicas: 1,
+		GPUCount: defaultGPUs,
+	}
+	for _, item := range strings.Split(spec, ",") {
+		kv := strings.SplitN(strings.TrimSpace(item), "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return task, fmt.Errorf("--task %s is invalid, expected key=value pairs separated by ','", spec)
+		}
+		key, value := kv[0], kv[1]
+		switch key {
+		case "name":
+			task.Name = value
+		case "replicas", "gpus", "npus", "min-available":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return task, fmt.Errorf("--task %s is invalid, %s must be a non-negative integer", spec, key)
+			}
+			switch key {
+			case "replicas":
+				task.Replicas = int32(n)
+			case "gpus", "npus":
+				task.GPUCount = n
+			default:
+				task.MinAvailable = int32(n)
+			}
+		case "cpu":
+			task.Cpu = value
+		case "memory":
+			task.Memory = value
+		case "nproc-per-node":
+			task.NprocPerNode = value
+		case "process-group":
+			processGroup, err := strconv.ParseBool(value)
+			if err != nil {
+				return task, fmt.Errorf("--task %s is invalid, process-group must be true or false", spec)
+			}
+			task.ProcessGroup = &processGroup
+		case "device":
+			device := strings.Replace(value, ":", "=", 1)
+			if err := util.ValidateDevices([]string{device}); err != nil {
+				return task, fmt.Errorf("--task %s is invalid, device must be <resource>:<count>: %v", spec, err)
+			}
+			if task.Devices == nil {
+				task.Devices = map[string]string{}
+			}
+			nameCount := strings.SplitN(device, "=", 2)
+			task.Devices[nameCount[0]] = nameCount[1]
+		default:
+			return task, fmt.Errorf("--task %s is invalid, unknown key %s", spec, key)
+		}
+	}
+	if task.Name == "" {
+		return task, fmt.Errorf("--task %s is invalid, name must be set", spec)
+	}
+	if errs := validation.IsDNS1123Label(task.Name); len(errs) != 0 {
+		return task, fmt.Errorf("--task %s is invalid, name %s is not a valid DNS label: %s", spec, task.Name, strings.Join(errs, ","))
+	}
+	return task, nil
+}
 
+// setPolicies parses the --policy flags into the lifecycle policies of the Volcano Job
+func (s *SubmitAppWrapperJobArgsBuilder) setPolicies() error {
+	p, ok := s.argValues["policy"]
+	if !ok {
+		return nil
+	}
+	s.args.Policies = nil
+	for _, spec := range *p.(*[]string) {
+		policy, err := parseVolcanoPolicy(spec)
+		if err != nil {
+			return err
+		}
+		s.args.Policies = append(s.args.Policies, policy)
+	}
+	return nil
+}
+
+// parseVolcanoPolicy parses a policy definition like "event=PodEvicted,action=RestartJob"
+func parseVolcanoPolicy(spec string) (types.VolcanoPolicyArgs, error) {
+	policy := types.VolcanoPolicyArgs{}
+	for _, item := range strings.Split(spec, ",") {
+		kv := strings.SplitN(strings.TrimSpace(item), "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return policy, fmt.Errorf("--policy %s is invalid, expected key=value pairs separated by ','", spec)
+		}
+		key, value := kv[0], kv[1]
+		switch key {
+		case "event":
+			policy.Event = value
+		case "exit-code":
+			n, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || n == 0 {
+				return policy, fmt.Errorf("--policy %s is invalid, exit-code must be a non-zero integer", spec)
+			}
+			policy.ExitCode = int32(n)
+		case "action":
+			policy.Action = value
+		case "timeout":
+			policy.Timeout = value
+		case "task":
+			policy.Task = value
+		default:
+			return policy, fmt.Errorf("--policy %s is invalid, unknown key %s", spec, key)
+		}
+	}
+	return policy, nil
+}
+
+// setAccelerator applies the typed accelerator request of --npus, the accelerator count
+// replaces --gpus in the request-gpus annotation and is the default of --nproc-per-node
+func (s *SubmitAppWrapperJobArgsBuilder) setAccelerator() error {
+	if s.args.AcceleratorCount == 0 {
+		if s.args.AcceleratorResource != "" {
+			return fmt.Errorf("--accelerator-resource must be used with --npus")
+		}
+		return nil
+	}
+	if s.args.AcceleratorCount < 0 {
+		return fmt.Errorf("--npus is invalid")
+	}
+	if s.args.GPUCount > 0 {
+		return fmt.Errorf("--gpus and --npus can not be used together")
+	}
+	if s.args.AcceleratorResource == "" {
+		s.args.AcceleratorResource = types.AscendNPUResourceName
+	}
+	if s.args.NprocPerNode == "" {
+		s.args.NprocPerNode = strconv.Itoa(s.args.AcceleratorCount)
+	}
+	// Ascend NPUs are allocated by the volcano ring controller, which also injects
+	// the visible devices used by HCCL
+	if s.args.RingController == "" && strings.HasPrefix(s.args.AcceleratorResource, "huawei.com/Ascend") {
+		s.args.RingController = defaultAscendRingController
+	}
+	if s.args.Envs == nil {
+		s.args.Envs = map[string]string{}
+	}
+	s.args.Envs["gpus"] = strconv.Itoa(s.args.AcceleratorCount)
+	if s.args.Annotations == nil {
+		s.args.Annotations = map[string]string{}
+	}
+	s.args.Annotations[types.RequestGPUsOfJobAnnoKey] = fmt.Sprintf("%v", s.args.WorkerCount*s.args.AcceleratorCount)
 	return nil
 }
 
+// acceleratorCount returns the number of accelerators of each replica, --npus takes precedence over --gpus
+func (s *SubmitAppWrapperJobArgsBuilder) acceleratorCount() int {
+	if s.args.AcceleratorCount > 0 {
+		return s.args.AcceleratorCount
+	}
+	return s.args.GPUCount
+}
+
 func (s *SubmitAppWrapperJobArgsBuilder) setRunPolicy() error {
 	// Get active deadline
 	if rt, ok := s.argValues["running-timeout"]; ok {
@@ -265,17 +503,8 @@ func (s *SubmitAppWrapperJobArgsBuilder) check() error {
 	}
 
 	// Check whether nprocPerNode is valid
-	switch s.args.NprocPerNode {
-	case "auto", "cpu", "gpu":
-		log.Debugf("Supported nprocPerNode: %s", s.args.NprocPerNode)
-	case "":
-		log.Debugf("--nproc-per-node is not set")
-	default:
-		nprocPerNode, err := strconv.Atoi(s.args.NprocPerNode)
-		if err != nil {
-			return fmt.Errorf("--nproc-per-node is invalid")
-		}
-		log.Debugf("Supported nprocPerNode: %d", nprocPerNode)
+	if !validNprocPerNode(s.args.NprocPerNode) {
+		return fmt.Errorf("--nproc-per-node is invalid")
 	}
 
 	// Check AppWrapper specific parameters
@@ -307,11 +536,36 @@ func (s *SubmitAppWrapperJobArgsBuilder) check() error {
 	}
 
 	// Check inner job type
-	switch s.args.InnerJobType {
-	case "pytorch", "volcano":
-		log.Debugf("Supported innerJobType: %s", s.args.InnerJobType)
-	default:
-		return fmt.Errorf("unsupported inner job type %s, supported types are 'pytorch' and 'volcano'", s.args.InnerJobType)
+	if !util.StringInSlice(s.args.InnerJobType, appWrapperInnerJobTypes) {
+		return fmt.Errorf("unsupported inner job type %s, supported types are %s", s.args.InnerJobType, strings.Join(appWrapperInnerJobTypes, ", "))
+	}
+	log.Debugf("Supported innerJobType: %s", s.args.InnerJobType)
+	if s.args.InnerJobType != "tfjob" && (s.args.PSCount > 0 || s.args.UseChief) {
+		return fmt.Errorf("--ps and --chief are only supported with --inner-type tfjob")
+	}
+	if err := s.checkInnerJob(); err != nil {
+		return err
+	}
+
+	if len(s.args.Tasks) > 0 && s.args.InnerJobType != "volcano" {
+		return fmt.Errorf("--task is only supported with --inner-type volcano")
+	}
+	if s.args.InnerJobType != "volcano" {
+		if s.args.VolcanoQueue != "" {
+			return fmt.Errorf("--volcano-queue is only supported with --inner-type volcano")
+		}
+		if len(s.args.Policies) > 0 {
+			return fmt.Errorf("--policy is only supported with --inner-type volcano")
+		}
+		if len(s.args.Plugins) > 0 {
+			return fmt.Errorf("--volcano-plugin is only supported with --inner-type volcano")
+		}
+		if s.args.RankTable {
+			return fmt.Errorf("--rank-table is only supported with --inner-type volcano")
+		}
+	}
+	if s.args.RankTable && !strings.HasPrefix(s.args.AcceleratorResource, "huawei.com/Ascend") {
+		return fmt.Errorf("--rank-table requires Ascend NPUs requested by --npus")
 	}
 
 	// Volcano-specific validations
@@ -341,6 +595,51 @@ func (s *SubmitAppWrapperJobArgsBuilder) check() error {
 			}
 		}
 
+		// Validate task definitions
+		taskNames := map[string]bool{}
+		for _, task := range s.args.Tasks {
+			if errs := validation.IsDNS1123Label(task.Name); len(errs) > 0 {
+				return fmt.Errorf("--task name %s is invalid: %s", task.Name, strings.Join(errs, ", "))
+			}
+			if taskNames[task.Name] {
+				return fmt.Errorf("--task name %s is duplicated", task.Name)
+			}
+			taskNames[task.Name] = true
+			if task.Replicas < 1 {
+				return fmt.Errorf("--task %s: replicas must be >= 1", task.Name)
+			}
+			if task.MinAvailable > task.Replicas {
+				return fmt.Errorf("--task %s: min-available %d is greater than replicas %d", task.Name, task.MinAvailable, task.Replicas)
+			}
+			if task.Cpu != "" {
+				if _, err := resource.ParseQuantity(task.Cpu); err != nil {
+					return fmt.Errorf("--task %s: cpu is invalid", task.Name)
+				}
+			}
+			if task.Memory != "" {
+				if _, err := resource.ParseQuantity(task.Memory); err != nil {
+					return fmt.Errorf("--task %s: memory is invalid", task.Name)
+				}
+			}
+			if !validNprocPerNode(task.NprocPerNode) {
+				return fmt.Errorf("--task %s: nproc-per-node is invalid", task.Name)
+			}
+		}
+
+		if s.args.VolcanoQueue != "" {
+			if errs := validation.IsDNS1123Subdomain(s.args.VolcanoQueue); len(errs) > 0 {
+				return fmt.Errorf("--volcano-queue %s is invalid: %s", s.args.VolcanoQueue, strings.Join(errs, ", "))
+			}
+		}
+		if err := s.checkPolicies(taskNames); err != nil {
+			return err
+		}
+		for _, plugin := range s.args.Plugins {
+			if !util.StringInSlice(plugin, volcanoPlugins) {
+				return fmt.Errorf("--volcano-plugin %s is not supported, supported plugins are %s", plugin, strings.Join(volcanoPlugins, ", "))
+			}
+		}
+
 		// Validate partition policy consistency
 		if s.args.TotalPartitions > 0 && s.args.PartitionSize <= 0 {
 			return fmt.Errorf("--partition-size must be specified when --total-partitions is set")
@@ -353,6 +652,109 @@ func (s *SubmitAppWrapperJobArgsBuilder) check() error {
 	return nil
 }
 
+// checkInnerJob validates the arguments of the wrapped job with the checks of the
+// builder of that kind, so the AppWrapper accepts what the standalone job accepts
+func (s *SubmitAppWrapperJobArgsBuilder) checkInnerJob() error {
+	switch s.args.InnerJobType {
+	case "tfjob":
+		if s.args.PSCount < 0 {
+			return fmt.Errorf("--ps is invalid")
+		}
+		tfArgs := &types.SubmitTFJobArgs{
+			CommonSubmitArgs:        s.args.CommonSubmitArgs,
+			PSCount:                 s.args.PSCount,
+			UseChief:                s.args.UseChief,
+			WorkerImage:             s.args.Image,
+			PSImage:                 s.args.Image,
+			CleanPodPolicy:          s.args.CleanPodPolicy,
+			SuccessPolicy:           TFJobSuccessPolicyDefault,
+			ActiveDeadlineSeconds:   s.args.ActiveDeadlineSeconds,
+			TTLSecondsAfterFinished: s.args.TTLSecondsAfterFinished,
+			ShareMemory:             s.args.ShareMemory,
+		}
+		return (&SubmitTFJobArgsBuilder{args: tfArgs}).check()
+	case "mpijob":
+		mpiArgs := &types.SubmitMPIJobArgs{
+			CommonSubmitArgs: s.args.CommonSubmitArgs,
+			Cpu:              s.args.Cpu,
+			Memory:           s.args.Memory,
+			CleanPodPolicy:   s.args.CleanPodPolicy,
+		}
+		return (&SubmitMPIJobArgsBuilder{args: mpiArgs}).check()
+	case "deepspeedjob":
+		deepspeedArgs := &types.SubmitDeepSpeedJobArgs{
+			CommonSubmitArgs: s.args.CommonSubmitArgs,
+			Cpu:              s.args.Cpu,
+			Memory:           s.args.Memory,
+		}
+		return (&SubmitDeepSpeedJobArgsBuilder{args: deepspeedArgs}).check()
+	case "rayjob":
+		if s.args.WorkerCount < 1 {
+			return fmt.Errorf("--workers must be greater than 0 for --inner-type rayjob")
+		}
+		rayArgs := &types.SubmitRayJobArgs{
+			CommonSubmitArgs:        s.args.CommonSubmitArgs,
+			ActiveDeadlineSeconds:   int32(s.args.ActiveDeadlineSeconds),
+			TTLSecondsAfterFinished: s.args.TTLSecondsAfterFinished,
+			ShareMemory:             s.args.ShareMemory,
+		}
+		// the head and the workers are rendered from the same pod template, the wrapped
+		// RayJob has no autoscaler and head service so only the job options are checked
+		rayArgs.HeadGroupSpec.Cpu = s.args.Cpu
+		rayArgs.HeadGroupSpec.Memory = s.args.Memory
+		rayArgs.WorkerGroupSpec.Cpu = s.args.Cpu
+		rayArgs.WorkerGroupSpec.Memory = s.args.Memory
+		rayArgs.WorkerGroupSpec.Gpu = s.args.GPUCount
+		rayArgs.WorkerGroupSpec.Replicas = int32(s.args.WorkerCount)
+		return (&SubmitRayJobArgsBuilder{args: rayArgs}).checkJobOptions()
+	case "job":
+		if s.args.WorkerCount < 1 {
+			return fmt.Errorf("--workers must be greater than 0 for --inner-type job")
+		}
+	}
+	return nil
+}
+
+// checkPolicies validates the lifecycle policies, taskNames are the names of the --task definitions
+func (s *SubmitAppWrapperJobArgsBuilder) checkPolicies(taskNames map[string]bool) error {
+	for _, policy := range s.args.Policies {
+		if (policy.Event == "") == (policy.ExitCode == 0) {
+			return fmt.Errorf("--policy: exactly one of event and exit-code must be set")
+		}
+		if policy.Event != "" && !util.StringInSlice(policy.Event, volcanoPolicyEvents) {
+			return fmt.Errorf("--policy: unsupported event %s, supported events are %s", policy.Event, strings.Join(volcanoPolicyEvents, ", "))
+		}
+		if !util.StringInSlice(policy.Action, volcanoPolicyActions) {
+			return fmt.Errorf("--policy: unsupported action %s, supported actions are %s", policy.Action, strings.Join(volcanoPolicyActions, ", "))
+		}
+		if policy.Timeout != "" {
+			if _, err := time.ParseDuration(policy.Timeout); err != nil {
+				return fmt.Errorf("--policy: timeout %s is invalid: %v", policy.Timeout, err)
+			}
+		}
+		if policy.Task == "" {
+			continue
+		}
+		if len(taskNames) == 0 && policy.Task != s.args.TaskName {
+			return fmt.Errorf("--policy: task %s does not exist, the task of the job is %s", policy.Task, s.args.TaskName)
+		}
+		if len(taskNames) > 0 && !taskNames[policy.Task] {
+			return fmt.Errorf("--policy: task %s does not exist in --task", policy.Task)
+		}
+	}
+	return nil
+}
+
+// validNprocPerNode tells whether nprocPerNode is empty, one of auto, cpu and gpu, or a number
+func validNprocPerNode(nprocPerNode string) bool {
+	switch nprocPerNode {
+	case "", "auto", "cpu", "gpu":
+		return true
+	}
+	_, err := strconv.Atoi(nprocPerNode)
+	return err == nil
+}
+
 // setAppWrapperAnnotations adds AppWrapper-specific annotations
 func (s *SubmitAppWrapperJobArgsBuilder) setAppWrapperAnnotations() error {
 	if s.args.Annotations == nil {
@@ -396,7 +798,7 @@ func (s *SubmitAppWrapperJobArgsBuilder) addEnv() error {
 
 	// Only set MASTER_ADDR for PyTorchJob mode
 	// For Volcano mode, MASTER_ADDR is set in the Helm template with correct DNS name
-	if s.args.EnableRDMA && s.args.InnerJobType != "volcano" {
+	if s.args.EnableRDMA && s.args.InnerJobType == "pytorch" {
 		s.args.Envs["MASTER_ADDR"] = fmt.Sprintf("%v-master-0", s.args.Name)
 	}
 
@@ -0,0 +1,131 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestParseAppWrapperTask(t *testing.T) {
+	processGroupDisabled := false
+	tests := []struct {
+		spec     string
+		expected types.AppWrapperTaskArgs
+		invalid  bool
+	}{
+		{
+			spec:     "name=worker",
+			expected: types.AppWrapperTaskArgs{Name: "worker", Replicas: 1, GPUCount: 4},
+		},
+		{
+			spec: "name=worker, replicas=8,gpus=8,min-available=6,cpu=32,memory=256Gi",
+			expected: types.AppWrapperTaskArgs{
+				Name:         "worker",
+				Replicas:     8,
+				GPUCount:     8,
+				MinAvailable: 6,
+				Cpu:          "32",
+				Memory:       "256Gi",
+			},
+		},
+		{
+			spec:     "name=master,replicas=1,npus=0",
+			expected: types.AppWrapperTaskArgs{Name: "master", Replicas: 1},
+		},
+		{
+			spec: "name=worker,device=rdma/hca:1,device=amd.com/gpu:2",
+			expected: types.AppWrapperTaskArgs{
+				Name:     "worker",
+				Replicas: 1,
+				GPUCount: 4,
+				Devices:  map[string]string{"rdma/hca": "1", "amd.com/gpu": "2"},
+			},
+		},
+		{
+			spec:     "name=coordinator,gpus=0,process-group=false,nproc-per-node=1",
+			expected: types.AppWrapperTaskArgs{Name: "coordinator", Replicas: 1, NprocPerNode: "1", ProcessGroup: &processGroupDisabled},
+		},
+		{spec: "", invalid: true},
+		{spec: "name=worker,process-group=maybe", invalid: true},
+		{spec: "replicas=2", invalid: true},
+		{spec: "name=worker,replicas", invalid: true},
+		{spec: "name=worker,replicas=", invalid: true},
+		{spec: "name=worker,replicas=-1", invalid: true},
+		{spec: "name=worker,gpus=two", invalid: true},
+		{spec: "name=worker,min-available=1.5", invalid: true},
+		{spec: "name=worker,image=busybox", invalid: true},
+		{spec: "name=worker;replicas=2", invalid: true},
+		{spec: "name=worker,device=rdma/hca", invalid: true},
+		{spec: "name=worker,device=rdma/hca:many", invalid: true},
+		{spec: "name=worker,device=nvidia.com/gpu:1", invalid: true},
+	}
+	for _, test := range tests {
+		task, err := parseAppWrapperTask(test.spec, 4)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("expected --task %q to be invalid, got %+v", test.spec, task)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("failed to parse --task %q: %v", test.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(task, test.expected) {
+			t.Errorf("expected --task %q to be %+v, got %+v", test.spec, test.expected, task)
+		}
+	}
+}
+
+func TestCheckInnerRayJob(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    types.SubmitAppWrapperJobArgs
+		invalid bool
+	}{
+		{
+			name: "valid",
+			args: types.SubmitAppWrapperJobArgs{CommonSubmitArgs: types.CommonSubmitArgs{WorkerCount: 2, GPUCount: 1}, Cpu: "4", Memory: "8Gi"},
+		},
+		{
+			name:    "no workers",
+			args:    types.SubmitAppWrapperJobArgs{},
+			invalid: true,
+		},
+		{
+			name:    "invalid share memory",
+			args:    types.SubmitAppWrapperJobArgs{CommonSubmitArgs: types.CommonSubmitArgs{WorkerCount: 1}, ShareMemory: "lots"},
+			invalid: true,
+		},
+		{
+			name:    "invalid ttl",
+			args:    types.SubmitAppWrapperJobArgs{CommonSubmitArgs: types.CommonSubmitArgs{WorkerCount: 1}, TTLSecondsAfterFinished: -1},
+			invalid: true,
+		},
+	}
+	for _, test := range tests {
+		test.args.InnerJobType = "rayjob"
+		err := (&SubmitAppWrapperJobArgsBuilder{args: &test.args}).checkInnerJob()
+		if test.invalid && err == nil {
+			t.Errorf("%s: expected the rayjob to be invalid", test.name)
+		}
+		if !test.invalid && err != nil {
+			t.Errorf("%s: expected the rayjob to be valid without the ray cluster options, got %v", test.name, err)
+		}
+	}
+}
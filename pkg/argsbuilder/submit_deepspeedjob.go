@@ -89,9 +89,13 @@ func (s *SubmitDeepSpeedJobArgsBuilder) AddCommandFlags(command *cobra.Command)
 	s.argValues["launcher-selector"] = &launcherSelectors
 	s.argValues["launcher-annotation"] = &launcherAnnotations
 	s.argValues["worker-annotation"] = &workerAnnotations
+	addSpecFileFlags(command, s)
 }
 
 func (s *SubmitDeepSpeedJobArgsBuilder) PreBuild() error {
+	if err := loadSpecFile(s.args, &s.args.Name, s.argValues); err != nil {
+		return err
+	}
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
@@ -160,12 +164,10 @@ func (s *SubmitDeepSpeedJobArgsBuilder) setEnv() error {
 
 func (s *SubmitDeepSpeedJobArgsBuilder) setLauncherSelectors() error {
 	log.Debug("begin setLauncherSelector")
-	s.args.LauncherSelectors = map[string]string{}
 	argKey := "launcher-selector"
 	var LauncherSelectors *[]string
 	value, ok := s.argValues[argKey]
 	if !ok {
-		log.Warnf("Fail to get key: %s", argKey)
 		return nil
 	}
 	LauncherSelectors = value.(*[]string)
@@ -96,9 +96,13 @@ func (s *SubmitETJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	s.argValues["launcher-selector"] = &launcherSelectors
 	s.argValues["launcher-annotation"] = &launcherAnnotations
 	s.argValues["worker-annotation"] = &workerAnnotations
+	addSpecFileFlags(command, s)
 }
 
 func (s *SubmitETJobArgsBuilder) PreBuild() error {
+	if err := loadSpecFile(s.args, &s.args.Name, s.argValues); err != nil {
+		return err
+	}
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
@@ -192,12 +196,10 @@ func (s *SubmitETJobArgsBuilder) setMaxWaitTime() error {
 
 func (s *SubmitETJobArgsBuilder) setLauncherSelectors() error {
 	log.Debug("begin setLauncherSelector")
-	s.args.LauncherSelectors = map[string]string{}
 	argKey := "launcher-selector"
 	var LauncherSelectors *[]string
 	value, ok := s.argValues[argKey]
 	if !ok {
-		log.Warnf("Fail to get key: %s", argKey)
 		return nil
 	}
 	LauncherSelectors = value.(*[]string)
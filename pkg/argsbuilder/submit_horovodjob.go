@@ -74,9 +74,13 @@ func (s *SubmitHorovodJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().IntVar(&s.args.SSHPort, "ssh-port", 0, "ssh port.")
 	command.Flags().StringVar(&s.args.Cpu, "cpu", "", "the cpu resource to use for the training, like 1 for 1 core.")
 	command.Flags().StringVar(&s.args.Memory, "memory", "", "the memory resource to use for the training, like 1Gi.")
+	addSpecFileFlags(command, s)
 }
 
 func (s *SubmitHorovodJobArgsBuilder) PreBuild() error {
+	if err := loadSpecFile(s.args, &s.args.Name, s.argValues); err != nil {
+		return err
+	}
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
@@ -77,9 +77,13 @@ func (s *SubmitMPIJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().BoolVar(&s.args.GPUTopology, "gputopology", false, "enable gpu topology scheduling")
 	command.Flags().BoolVar(&s.args.MountsOnLauncher, "mounts-on-launcher", false, "launcher also mounts pvc")
 	command.Flags().StringVar(&s.args.CleanPodPolicy, "clean-task-policy", "All", "How to clean tasks after Training is done, support None, Running, All.")
+	addSpecFileFlags(command, s)
 }
 
 func (s *SubmitMPIJobArgsBuilder) PreBuild() error {
+	if err := loadSpecFile(s.args, &s.args.Name, s.argValues); err != nil {
+		return err
+	}
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
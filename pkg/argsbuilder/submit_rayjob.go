@@ -110,9 +110,13 @@ func (s *SubmitRayJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	s.AddArgValue("active-deadline-seconds", &activeDeadline).
 		AddArgValue("ttl-after-finished", &ttlAfterFinished).
 		AddArgValue("pre-stop-command", &preStopCmd)
+	addSpecFileFlags(command, s)
 }
 
 func (s *SubmitRayJobArgsBuilder) PreBuild() error {
+	if err := loadSpecFile(s.args, &s.args.Name, s.argValues); err != nil {
+		return err
+	}
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
@@ -173,18 +177,15 @@ func (s *SubmitRayJobArgsBuilder) check() error {
 	if (s.args.HeadGroupSpec.Image == "" || s.args.WorkerGroupSpec.Image == "") && s.args.Image == "" {
 		return fmt.Errorf("--image must be set when neither --head-image nor --worker-image is provided")
 	}
-	if s.args.ActiveDeadlineSeconds < 0 {
-		return fmt.Errorf("--active-deadline-seconds is invalid")
-	}
-	if s.args.TTLSecondsAfterFinished < 0 {
-		return fmt.Errorf("--ttl-after-finished is invalid")
-	}
-	if s.args.ShareMemory != "" {
-		_, err := resource.ParseQuantity(s.args.ShareMemory)
-		if err != nil {
-			return fmt.Errorf("--share-memory is invalid")
-		}
+	if err := s.checkClusterOptions(); err != nil {
+		return err
 	}
+	return s.checkJobOptions()
+}
+
+// checkClusterOptions validates the autoscaler and the head service of the ray cluster,
+// which the RayJob wrapped by an AppWrapper does not have
+func (s *SubmitRayJobArgsBuilder) checkClusterOptions() error {
 	if s.args.AutoscalerOptions.Cpu != "" {
 		_, err := resource.ParseQuantity(s.args.AutoscalerOptions.Cpu)
 		if err != nil {
@@ -211,6 +212,30 @@ func (s *SubmitRayJobArgsBuilder) check() error {
 	default:
 		return fmt.Errorf("unsupported autoscalerUpscalingMode: %s", s.args.AutoscalerOptions.UpscalingMode)
 	}
+	// check head-service-type
+	switch s.args.HeadGroupSpec.ServiceType {
+	case "ClusterIP", "NodePort", "LoadBalancer", "ExternalName":
+		log.Debugf("Supported headServiceType: %s", s.args.HeadGroupSpec.ServiceType)
+	default:
+		return fmt.Errorf("unsupported headServiceType: %s", s.args.HeadGroupSpec.ServiceType)
+	}
+	return nil
+}
+
+// checkJobOptions validates the run policy and the groups of the RayJob
+func (s *SubmitRayJobArgsBuilder) checkJobOptions() error {
+	if s.args.ActiveDeadlineSeconds < 0 {
+		return fmt.Errorf("--active-deadline-seconds is invalid")
+	}
+	if s.args.TTLSecondsAfterFinished < 0 {
+		return fmt.Errorf("--ttl-after-finished is invalid")
+	}
+	if s.args.ShareMemory != "" {
+		_, err := resource.ParseQuantity(s.args.ShareMemory)
+		if err != nil {
+			return fmt.Errorf("--share-memory is invalid")
+		}
+	}
 	if s.args.HeadGroupSpec.Gpu < 0 {
 		return fmt.Errorf("--head-gpu is invalid")
 	}
@@ -226,13 +251,6 @@ func (s *SubmitRayJobArgsBuilder) check() error {
 			return fmt.Errorf("--head-memory is invalid")
 		}
 	}
-	// check head-service-type
-	switch s.args.HeadGroupSpec.ServiceType {
-	case "ClusterIP", "NodePort", "LoadBalancer", "ExternalName":
-		log.Debugf("Supported headServiceType: %s", s.args.HeadGroupSpec.ServiceType)
-	default:
-		return fmt.Errorf("unsupported headServiceType: %s", s.args.HeadGroupSpec.ServiceType)
-	}
 	if s.args.WorkerGroupSpec.Gpu < 0 {
 		return fmt.Errorf("--worker-gpu is invalid")
 	}
@@ -67,6 +67,7 @@ func (s *SubmitSparkJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	var (
 		annotations []string
 		labels      []string
+		dryRun      string
 	)
 	command.Flags().StringVar(&s.args.Name, "name", "", "override name")
 	_ = command.MarkFlagRequired("name")
@@ -86,15 +87,23 @@ func (s *SubmitSparkJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 
 	command.Flags().StringArrayVarP(&annotations, "annotation", "a", []string{}, `the annotations, usage: "--annotation=key=value" or "--annotation key=value"`)
 	command.Flags().StringArrayVarP(&labels, "label", "l", []string{}, "specify the label")
-	s.AddArgValue("annotation", &annotations).AddArgValue("label", &labels)
+	AddDryRunFlag(command, &dryRun)
+	s.AddArgValue("annotation", &annotations).AddArgValue("label", &labels).AddArgValue("dry-run", &dryRun)
+	addSpecFileFlags(command, s)
 }
 
 func (s *SubmitSparkJobArgsBuilder) PreBuild() error {
+	if err := loadSpecFile(s.args, &s.args.Name, s.argValues); err != nil {
+		return err
+	}
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
 		}
 	}
+	if err := s.setDryRun(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -187,3 +196,17 @@ func (s *SubmitSparkJobArgsBuilder) setUserNameAndUserId() error {
 	s.args.Annotations[types.UserNameNameLabel] = user.GetName()
 	return nil
 }
+
+// setDryRun is used to handle option --dry-run
+func (s *SubmitSparkJobArgsBuilder) setDryRun() error {
+	item, ok := s.argValues["dry-run"]
+	if !ok {
+		return nil
+	}
+	strategy, err := ParseDryRunStrategy(*item.(*string))
+	if err != nil {
+		return err
+	}
+	s.args.DryRun = strategy
+	return nil
+}
@@ -193,9 +193,13 @@ func (s *SubmitTFJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 		AddArgValue("running-timeout", &runningTimeout).
 		AddArgValue("starting-timeout", &startingTimeout).
 		AddArgValue("ttl-after-finished", &ttlAfterFinished)
+	addSpecFileFlags(command, s)
 }
 
 func (s *SubmitTFJobArgsBuilder) PreBuild() error {
+	if err := loadSpecFile(s.args, &s.args.Name, s.argValues); err != nil {
+		return err
+	}
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
@@ -452,7 +456,9 @@ func (s *SubmitTFJobArgsBuilder) checkGangCapablitiesInCluster() error {
 
 // add node selectors
 func (s *SubmitTFJobArgsBuilder) setTFNodeSelectors() error {
-	s.args.TFNodeSelectors = map[string]map[string]string{}
+	if s.args.TFNodeSelectors == nil {
+		s.args.TFNodeSelectors = map[string]map[string]string{}
+	}
 	var (
 		psSelectors        *[]string
 		workerSelectors    *[]string
@@ -491,12 +497,15 @@ func (s *SubmitTFJobArgsBuilder) setTFNodeSelectors() error {
 }
 
 func (s *SubmitTFJobArgsBuilder) transformSelectorArrayToMap(selectorArray *[]string, role string) {
-	s.args.TFNodeSelectors[role] = map[string]string{}
 	if selectorArray != nil && len(*selectorArray) != 0 {
 		log.Debugf("%v Selectors: %v", role, selectorArray)
 		s.args.TFNodeSelectors[role] = transformSliceToMap(*selectorArray, "=")
 		return
 	}
+	// keep the node selectors of the role loaded from the spec file
+	if len(s.args.TFNodeSelectors[role]) > 0 {
+		return
+	}
 	// set the default node selectors to tf role node selectors
 	log.Debugf("use to Node Selectors %v to %v Selector", s.args.NodeSelectors, role)
 	s.args.TFNodeSelectors[role] = s.args.NodeSelectors
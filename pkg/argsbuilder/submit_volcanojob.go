@@ -66,6 +66,7 @@ func (s *SubmitVolcanoJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	var (
 		annotations []string
 		labels      []string
+		dryRun      string
 	)
 	command.Flags().StringVar(&s.args.Name, "name", "", "assign the job name")
 	_ = command.MarkFlagRequired("name")
@@ -98,15 +99,23 @@ func (s *SubmitVolcanoJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().IntVar(&s.args.TaskPort, "task-port", 2222, "the task port number. default value is 2222")
 	command.Flags().StringArrayVarP(&annotations, "annotation", "a", []string{}, `the annotations, usage: "--annotation=key=value" or "--annotation key=value"`)
 	command.Flags().StringArrayVarP(&labels, "label", "l", []string{}, "specify the label")
-	s.AddArgValue("annotation", &annotations).AddArgValue("label", &labels)
+	AddDryRunFlag(command, &dryRun)
+	s.AddArgValue("annotation", &annotations).AddArgValue("label", &labels).AddArgValue("dry-run", &dryRun)
+	addSpecFileFlags(command, s)
 }
 
 func (s *SubmitVolcanoJobArgsBuilder) PreBuild() error {
+	if err := loadSpecFile(s.args, &s.args.Name, s.argValues); err != nil {
+		return err
+	}
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
 		}
 	}
+	if err := s.setDryRun(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -199,3 +208,17 @@ func (s *SubmitVolcanoJobArgsBuilder) setUserNameAndUserId() error {
 	s.args.Annotations[types.UserNameNameLabel] = user.GetName()
 	return nil
 }
+
+// setDryRun is used to handle option --dry-run
+func (s *SubmitVolcanoJobArgsBuilder) setDryRun() error {
+	item, ok := s.argValues["dry-run"]
+	if !ok {
+		return nil
+	}
+	strategy, err := ParseDryRunStrategy(*item.(*string))
+	if err != nil {
+		return err
+	}
+	s.args.DryRun = strategy
+	return nil
+}
@@ -19,6 +19,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/spf13/cobra"
+
 	"github.com/kubeflow/arena/pkg/apis/types"
 )
 
@@ -279,3 +281,19 @@ func split(value, sep string) (string, string) {
 	index := strings.Index(value, sep)
 	return value[:index], value[index+1:]
 }
+
+// AddDryRunFlag adds option --dry-run, a bare --dry-run means --dry-run=client
+func AddDryRunFlag(command *cobra.Command, dryRun *string) {
+	command.Flags().StringVar(dryRun, "dry-run", "", `only print the rendered manifests without submitting the job, usage: "--dry-run", "--dry-run=client" or "--dry-run=server" (also validate them by a server side dry run)`)
+	command.Flags().Lookup("dry-run").NoOptDefVal = string(types.DryRunClient)
+}
+
+// ParseDryRunStrategy parses the value of option --dry-run
+func ParseDryRunStrategy(value string) (types.DryRunStrategy, error) {
+	switch strategy := types.DryRunStrategy(value); strategy {
+	case types.DryRunNone, types.DryRunClient, types.DryRunServer:
+		return strategy, nil
+	default:
+		return types.DryRunNone, fmt.Errorf("invalid --dry-run value '%s': must be client or server", value)
+	}
+}
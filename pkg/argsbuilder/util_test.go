@@ -0,0 +1,76 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestParseDryRunStrategy(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected types.DryRunStrategy
+		invalid  bool
+	}{
+		{value: "", expected: types.DryRunNone},
+		{value: "client", expected: types.DryRunClient},
+		{value: "server", expected: types.DryRunServer},
+		{value: "true", invalid: true},
+		{value: "Client", invalid: true},
+	}
+	for _, test := range tests {
+		strategy, err := ParseDryRunStrategy(test.value)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("expected --dry-run=%q to be invalid, got %q", test.value, strategy)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("failed to parse --dry-run=%q: %v", test.value, err)
+			continue
+		}
+		if strategy != test.expected {
+			t.Errorf("expected --dry-run=%q to be %q, got %q", test.value, test.expected, strategy)
+		}
+	}
+}
+
+func TestAddDryRunFlag(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{args: []string{}, expected: ""},
+		{args: []string{"--dry-run"}, expected: "client"},
+		{args: []string{"--dry-run=server"}, expected: "server"},
+	}
+	for _, test := range tests {
+		var dryRun string
+		command := &cobra.Command{Use: "test"}
+		AddDryRunFlag(command, &dryRun)
+		if err := command.ParseFlags(test.args); err != nil {
+			t.Errorf("failed to parse %v: %v", test.args, err)
+			continue
+		}
+		if dryRun != test.expected {
+			t.Errorf("expected %v to be --dry-run=%q, got %q", test.args, test.expected, dryRun)
+		}
+	}
+}
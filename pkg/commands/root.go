@@ -22,6 +22,7 @@ import (
 	"github.com/kubeflow/arena/pkg/commands/data"
 	"github.com/kubeflow/arena/pkg/commands/evaluate"
 	"github.com/kubeflow/arena/pkg/commands/model"
+	"github.com/kubeflow/arena/pkg/commands/queue"
 	"github.com/kubeflow/arena/pkg/commands/serving"
 	"github.com/kubeflow/arena/pkg/commands/top"
 	"github.com/kubeflow/arena/pkg/commands/training"
@@ -62,9 +63,16 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(training.NewLogViewerCommand())
 	command.AddCommand(training.NewLogsCommand())
 	command.AddCommand(training.NewDeleteCommand())
+	command.AddCommand(training.NewSuspendCommand())
+	command.AddCommand(training.NewResumeCommand())
+	command.AddCommand(training.NewResubmitCommand())
+	command.AddCommand(training.NewExportCommand())
+	command.AddCommand(training.NewWaitCommand())
+	command.AddCommand(training.NewDiagnoseCommand())
 	command.AddCommand(top.NewTopCommand())
 	command.AddCommand(NewVersionCmd(CLIName))
 	command.AddCommand(data.NewDataCommand())
+	command.AddCommand(queue.NewQueueCommand())
 	command.AddCommand(cron.NewCronCommand())
 	command.AddCommand(NewCompletionCommand())
 	command.AddCommand(evaluate.NewEvaluateCommand())
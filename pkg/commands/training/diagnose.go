@@ -0,0 +1,65 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewDiagnoseCommand finds the root causes of a stuck or failed training job
+func NewDiagnoseCommand() *cobra.Command {
+	var trainingType string
+	var format string
+	var command = &cobra.Command{
+		Use:   "diagnose JOB [-T JOB_TYPE] [-o json|yaml|wide]",
+		Short: "Diagnose why a training job is stuck or failed",
+		Long: `Diagnose why a training job is stuck or failed.
+The pods, events, nodes, volumes and the AppWrapper, Kueue and Volcano states of the job are checked,
+the problems found are printed with suggested fixes, the most severe first.`,
+		Example: `  arena diagnose tf-job
+  arena diagnose aw-job -T appwrapperjob -o json`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			name := args[0]
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Training().DiagnoseAndPrint(name, utils.TransferTrainingJobType(trainingType), format)
+		},
+	}
+	command.Flags().StringVarP(&trainingType, "type", "T", "", fmt.Sprintf("The training type, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().StringVarP(&format, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
@@ -15,6 +15,7 @@
 package training
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -33,6 +34,7 @@ func NewGetCommand() *cobra.Command {
 	var showEvents bool
 	var showGPUs bool
 	var output string
+	var watch bool
 	var command = &cobra.Command{
 		Use:   "get JOB [-T JOB_TYPE]",
 		Short: "Display a training job details",
@@ -50,15 +52,19 @@ func NewGetCommand() *cobra.Command {
 			}()
 			name := args[0]
 			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
-				Kubeconfig:     viper.GetString("config"),
-				LogLevel:       viper.GetString("loglevel"),
-				Namespace:      viper.GetString("namespace"),
-				ArenaNamespace: viper.GetString("arena-namespace"),
-				IsDaemonMode:   false,
+				Kubeconfig:       viper.GetString("config"),
+				LogLevel:         viper.GetString("loglevel"),
+				Namespace:        viper.GetString("namespace"),
+				ArenaNamespace:   viper.GetString("arena-namespace"),
+				IsDaemonMode:     watch,
+				DaemonNamespaced: true,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to create arena client: %v", err)
 			}
+			if watch {
+				return client.Training().GetAndWatch(context.Background(), name, utils.TransferTrainingJobType(jobType), output, showEvents, showGPUs)
+			}
 			return client.Training().GetAndPrint(name, utils.TransferTrainingJobType(jobType), output, showEvents, showGPUs)
 		},
 	}
@@ -66,5 +72,6 @@ func NewGetCommand() *cobra.Command {
 	command.Flags().BoolVarP(&showEvents, "events", "e", false, "Specify if show pending pod's events.")
 	command.Flags().BoolVarP(&showGPUs, "gpus", "g", false, "Specify if show gpu utilizations of job.")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "Watch the training job and refresh it on every change")
 	return command
 }
@@ -15,6 +15,7 @@
 package training
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -30,6 +31,7 @@ func NewListCommand() *cobra.Command {
 	var allNamespaces bool
 	var format string
 	var jobType string
+	var watch bool
 	var command = &cobra.Command{
 		Use:     "list",
 		Short:   "List all the training jobs",
@@ -43,15 +45,19 @@ func NewListCommand() *cobra.Command {
 				log.Debugf("execute time of listing training jobs: %v\n", time.Since(now))
 			}()
 			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
-				Kubeconfig:     viper.GetString("config"),
-				LogLevel:       viper.GetString("loglevel"),
-				Namespace:      viper.GetString("namespace"),
-				ArenaNamespace: viper.GetString("arena-namespace"),
-				IsDaemonMode:   false,
+				Kubeconfig:       viper.GetString("config"),
+				LogLevel:         viper.GetString("loglevel"),
+				Namespace:        viper.GetString("namespace"),
+				ArenaNamespace:   viper.GetString("arena-namespace"),
+				IsDaemonMode:     watch,
+				DaemonNamespaced: !allNamespaces,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to create arena client: %v", err)
 			}
+			if watch {
+				return client.Training().ListAndWatch(context.Background(), allNamespaces, format, utils.TransferTrainingJobType(jobType))
+			}
 			return client.Training().ListAndPrint(allNamespaces, format, utils.TransferTrainingJobType(jobType))
 		},
 	}
@@ -60,5 +66,6 @@ func NewListCommand() *cobra.Command {
 	_ = command.Flags().MarkDeprecated("allNamespaces", "please use --all-namespaces instead")
 	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "show all the namespaces")
 	command.Flags().StringVarP(&format, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "Watch the training jobs and refresh them on every change")
 	return command
 }
@@ -28,8 +28,14 @@ import (
 func NewLogsCommand() *cobra.Command {
 	loggerBuilder := logger.NewLoggerBuilder()
 	var jobType string
+	var allInstances bool
+	var role string
+	var ranks string
+	var merge bool
+	var grep string
+	var attempt int
 	var command = &cobra.Command{
-		Use:     "logs JOB [-T JOB_TYPE]",
+		Use:     "logs JOB [-T JOB_TYPE] [--all|--role ROLE|--ranks RANKS|--attempt N]",
 		Short:   "Print the logs of a training job",
 		Aliases: []string{"log"},
 		PreRun: func(cmd *cobra.Command, args []string) {
@@ -51,7 +57,13 @@ func NewLogsCommand() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create arena client: %v", err)
 			}
-			logArgs, err := loggerBuilder.Build()
+			if allInstances {
+				loggerBuilder.AllInstances()
+			}
+			if merge {
+				loggerBuilder.Merge()
+			}
+			logArgs, err := loggerBuilder.Role(role).Ranks(ranks).Grep(grep).Attempt(attempt).Build()
 			if err != nil {
 				return fmt.Errorf("failed to validate log args: %v", err)
 			}
@@ -60,5 +72,11 @@ func NewLogsCommand() *cobra.Command {
 	}
 	loggerBuilder.AddCommandFlags(command)
 	command.Flags().StringVarP(&jobType, "type", "T", "", fmt.Sprintf("The training type to show logging, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().BoolVar(&allInstances, "all", false, "Stream the logs of all the instances concurrently, every line is prefixed with [pod/rank]")
+	command.Flags().StringVar(&role, "role", "", "Stream the logs of the instances of the role concurrently, e.g. worker")
+	command.Flags().StringVar(&ranks, "ranks", "", "Stream the logs of the instances of the ranks concurrently, e.g. 0-7,9")
+	command.Flags().BoolVar(&merge, "merge", false, "Order the lines of the instances by their timestamps, used with --all, --role or --ranks")
+	command.Flags().StringVar(&grep, "grep", "", "Only print the lines matching the regular expression, used with --all, --role, --ranks or --attempt")
+	command.Flags().IntVar(&attempt, "attempt", -1, "Print the logs of the attempt archived by the log archiver, 0 is the original run and N is the N-th retry of the job")
 	return command
 }
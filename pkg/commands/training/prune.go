@@ -15,17 +15,36 @@ package training
 
 import (
 	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/kubeflow/arena/pkg/apis/arenaclient"
 	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+// pruneStatuses are the statuses of the jobs which can be pruned
+var pruneStatuses = []types.TrainingJobStatus{
+	types.TrainingJobQueuing,
+	types.TrainingJobPending,
+	types.TrainingJobSucceeded,
+	types.TrainingJobFailed,
+	types.TrainingJobSuspended,
+}
+
 func NewPruneCommand() *cobra.Command {
 	var allNamespaces bool
 	var since time.Duration
+	var statuses string
+	var jobType string
+	var selector string
+	var user string
+	var keepLast int
+	var dryRun bool
 	var command = &cobra.Command{
 		Use:   "prune history job",
 		Short: "Prune the history jobs",
@@ -33,6 +52,14 @@ func NewPruneCommand() *cobra.Command {
 			_ = viper.BindPFlags(cmd.Flags())
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			trainingType := utils.TransferTrainingJobType(jobType)
+			if trainingType == types.UnknownTrainingJob {
+				return fmt.Errorf("unknown training job type %v, the possible option is %v", jobType, utils.GetSupportTrainingJobTypesInfo())
+			}
+			jobStatuses, err := parsePruneStatuses(statuses)
+			if err != nil {
+				return err
+			}
 			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
 				Kubeconfig:     viper.GetString("config"),
 				LogLevel:       viper.GetString("loglevel"),
@@ -43,10 +70,68 @@ func NewPruneCommand() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create arena client: %v", err)
 			}
-			return client.Training().Prune(allNamespaces, since)
+			jobs, err := client.Training().Prune(types.PruneArgs{
+				AllNamespaces: allNamespaces,
+				Since:         since,
+				Statuses:      jobStatuses,
+				JobType:       trainingType,
+				LabelSelector: selector,
+				User:          user,
+				KeepLast:      keepLast,
+				DryRun:        dryRun,
+			})
+			if err != nil && len(jobs) == 0 {
+				return err
+			}
+			printPrunedJobs(jobs, dryRun)
+			return err
 		},
 	}
 	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "prune training jobs in all the namespaces")
 	command.Flags().DurationVarP(&since, "since", "s", -1, "Clean job that live longer than relative duration like 5s, 2m, or 3h.")
+	command.Flags().StringVar(&statuses, "status", "", fmt.Sprintf("Only clean the jobs of the statuses, e.g. FAILED,SUCCEEDED, the possible status is %v", pruneStatuses))
+	command.Flags().StringVarP(&jobType, "type", "T", "", fmt.Sprintf("Only clean the jobs of the training type, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Only clean the jobs matching the label selector, e.g. -l key1=value1,key2=value2")
+	command.Flags().StringVar(&user, "user", "", "Only clean the jobs submitted by the user")
+	command.Flags().IntVar(&keepLast, "keep-last", 0, "Keep the latest N jobs of every user")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Only print the jobs which would be cleaned")
 	return command
 }
+
+func parsePruneStatuses(value string) ([]types.TrainingJobStatus, error) {
+	statuses := []types.TrainingJobStatus{}
+	for _, item := range strings.Split(value, ",") {
+		status := types.TrainingJobStatus(strings.ToUpper(strings.TrimSpace(item)))
+		if status == "" {
+			continue
+		}
+		supported := false
+		for _, s := range pruneStatuses {
+			if s == status {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return nil, fmt.Errorf("unsupported status %s, the possible status is %v", item, pruneStatuses)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func printPrunedJobs(jobs []*types.PrunedTrainingJob, dryRun bool) {
+	if len(jobs) == 0 {
+		fmt.Println("No job need to be deleted")
+		return
+	}
+	if dryRun {
+		fmt.Println("The following jobs would be deleted (dry run):")
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tTYPE\tSTATUS\tAGE")
+	for _, job := range jobs {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", job.Namespace, job.Name, job.Type, job.Status, job.Age)
+	}
+	_ = w.Flush()
+}
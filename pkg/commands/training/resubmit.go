@@ -0,0 +1,78 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+// NewResubmitCommand resubmits a training job from the values stored at its submission
+func NewResubmitCommand() *cobra.Command {
+	var (
+		trainingType string
+		dryRun       string
+		resubmitArgs types.ResubmitArgs
+	)
+	var command = &cobra.Command{
+		Use:     "resubmit JOB [--name NEW_JOB] [--set key=value]",
+		Aliases: []string{"clone"},
+		Short:   "Submit a training job again with its stored values, the values can be overridden",
+		Example: `  # retry a failed job with a changed hyperparameter under a new name
+  arena resubmit my-job --name my-job-2 --set envs.LEARNING_RATE=0.01
+
+  # delete the job and submit it again with the same name
+  arena resubmit my-job --replace`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			strategy, err := argsbuilder.ParseDryRunStrategy(dryRun)
+			if err != nil {
+				return err
+			}
+			resubmitArgs.DryRun = strategy
+			name := args[0]
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Training().Resubmit(name, utils.TransferTrainingJobType(trainingType), &resubmitArgs)
+		},
+	}
+	command.Flags().StringVarP(&trainingType, "type", "T", "", fmt.Sprintf("The training type, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().StringVar(&resubmitArgs.Name, "name", "", "the name of the new job, the job is resubmitted with its own name if not set and --replace is required")
+	command.Flags().BoolVar(&resubmitArgs.Replace, "replace", false, "delete the job before resubmitting it with the same name")
+	command.Flags().StringArrayVar(&resubmitArgs.Sets, "set", []string{}, `override the stored values of the job, usage: "--set key=value", the key can be a path like "envs.LEARNING_RATE"`)
+	argsbuilder.AddDryRunFlag(command, &dryRun)
+	return command
+}
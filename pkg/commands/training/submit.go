@@ -14,7 +14,14 @@
 
 package training
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/training"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
 
 var (
 	submitLong = `Submit a job.
@@ -53,3 +60,13 @@ func NewSubmitCommand() *cobra.Command {
 	command.AddCommand(NewSubmitAppWrapperJobCommand())
 	return command
 }
+
+// printSubmitSchema prints the JSON schema of the spec file of option -f
+func printSubmitSchema(jobType types.TrainingJobType) error {
+	schema, err := training.SubmitArgsSchema(jobType)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(schema))
+	return nil
+}
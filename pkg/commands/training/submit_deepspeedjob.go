@@ -36,7 +36,10 @@ func NewSubmitDeepSpeedJobCommand() *cobra.Command {
 			_ = viper.BindPFlags(cmd.Flags())
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 0 {
+			if viper.GetBool("print-schema") {
+				return printSubmitSchema(types.DeepSpeedTrainingJob)
+			}
+			if len(args) == 0 && viper.GetString("filename") == "" {
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("not found command args")
 			}
@@ -57,6 +60,9 @@ func NewSubmitDeepSpeedJobCommand() *cobra.Command {
 			if err := client.Training().Submit(job); err != nil {
 				return err
 			}
+			if viper.GetString("dry-run") != "" {
+				return nil
+			}
 			fullSubmitCommand := getFullSubmitCommand(cmd, args)
 			_, modelVersion, err := createRegisteredModelAndModelVersion(client, job, fullSubmitCommand)
 			if modelVersion == nil {
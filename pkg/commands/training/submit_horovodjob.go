@@ -37,7 +37,10 @@ func NewSubmitHorovodJobCommand() *cobra.Command {
 			_ = viper.BindPFlags(cmd.Flags())
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 0 {
+			if viper.GetBool("print-schema") {
+				return printSubmitSchema(types.HorovodTrainingJob)
+			}
+			if len(args) == 0 && viper.GetString("filename") == "" {
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("not found command args")
 			}
@@ -58,6 +61,9 @@ func NewSubmitHorovodJobCommand() *cobra.Command {
 			if err := client.Training().Submit(job); err != nil {
 				return err
 			}
+			if viper.GetString("dry-run") != "" {
+				return nil
+			}
 			fullSubmitCommand := getFullSubmitCommand(cmd, args)
 			_, modelVersion, err := createRegisteredModelAndModelVersion(client, job, fullSubmitCommand)
 			if modelVersion == nil {
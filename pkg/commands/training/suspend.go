@@ -0,0 +1,77 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewSuspendCommand suspends a training job, the job definition is kept
+func NewSuspendCommand() *cobra.Command {
+	return newSuspendOrResumeCommand(true)
+}
+
+// NewResumeCommand resumes a suspended training job
+func NewResumeCommand() *cobra.Command {
+	return newSuspendOrResumeCommand(false)
+}
+
+func newSuspendOrResumeCommand(suspend bool) *cobra.Command {
+	var trainingType string
+	use := "resume JOB [-T JOB_TYPE]"
+	short := "Resume a suspended training job"
+	if suspend {
+		use = "suspend JOB [-T JOB_TYPE]"
+		short = "Suspend a training job and release its resources, the job can be resumed later"
+	}
+	var command = &cobra.Command{
+		Use:   use,
+		Short: short,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			name := args[0]
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			jobType := utils.TransferTrainingJobType(trainingType)
+			if suspend {
+				return client.Training().Suspend(name, jobType)
+			}
+			return client.Training().Resume(name, jobType)
+		},
+	}
+	command.Flags().StringVarP(&trainingType, "type", "T", "", fmt.Sprintf("The training type, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	return command
+}
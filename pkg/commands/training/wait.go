@@ -0,0 +1,121 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// waitStatuses are the statuses supported by the option --for
+var waitStatuses = []types.TrainingJobStatus{
+	types.TrainingJobQueuing,
+	types.TrainingJobPending,
+	types.TrainingJobRunning,
+	types.TrainingJobSucceeded,
+	types.TrainingJobFailed,
+	types.TrainingJobSuspended,
+}
+
+// NewWaitCommand waits for a training job to reach the expected status
+func NewWaitCommand() *cobra.Command {
+	var trainingType string
+	var condition string
+	var timeout time.Duration
+	var command = &cobra.Command{
+		Use:   "wait JOB --for=status=STATUS[,STATUS...] [--timeout DURATION]",
+		Short: "Wait for a training job to reach the expected status",
+		Long: `Wait for a training job to reach the expected status.
+The command exits with 0 when the job reaches one of the statuses, 2 when the job finished
+in another status and 124 when the timeout is exceeded.`,
+		Example: `  arena wait tf-job --for=status=SUCCEEDED --timeout 2h
+  arena wait tf-job --for=status=SUCCEEDED,FAILED`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			name := args[0]
+			statuses, err := parseWaitCondition(condition)
+			if err != nil {
+				return err
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			ctx := context.Background()
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			job, err := client.Training().Wait(ctx, name, utils.TransferTrainingJobType(trainingType), types.TrainingJobStatusIn(statuses...))
+			if job != nil {
+				fmt.Printf("%s/%s %s\n", job.Trainer, job.Name, job.Status)
+			}
+			return err
+		},
+	}
+	command.Flags().StringVarP(&trainingType, "type", "T", "", fmt.Sprintf("The training type, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().StringVar(&condition, "for", "", fmt.Sprintf("The condition to wait for, in the form of status=STATUS[,STATUS...], the possible status is %v", waitStatuses))
+	command.Flags().DurationVar(&timeout, "timeout", 0, "The duration to wait before giving up, e.g. 30m or 2h, zero means waiting forever")
+	return command
+}
+
+// parseWaitCondition parses the statuses of the condition "status=A,B", "|" is also accepted as the separator
+func parseWaitCondition(condition string) ([]types.TrainingJobStatus, error) {
+	value, ok := strings.CutPrefix(condition, "status=")
+	if !ok {
+		return nil, fmt.Errorf("invalid condition %q, please set it like --for=status=SUCCEEDED", condition)
+	}
+	statuses := []types.TrainingJobStatus{}
+	for _, item := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '|' }) {
+		status := types.TrainingJobStatus(strings.ToUpper(strings.TrimSpace(item)))
+		supported := false
+		for _, s := range waitStatuses {
+			if s == status {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return nil, fmt.Errorf("unsupported status %s, the possible status is %v", item, waitStatuses)
+		}
+		statuses = append(statuses, status)
+	}
+	if len(statuses) == 0 {
+		return nil, fmt.Errorf("invalid condition %q, no status is set", condition)
+	}
+	return statuses, nil
+}
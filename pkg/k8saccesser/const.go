@@ -41,4 +41,7 @@ const (
 
 	AppWrapperCRDName             = "appwrappers.workload.codeflare.dev"
 	AppWrapperCRDNameInDaemonMode = "AppWrapper.workload.codeflare.dev"
+
+	KueueWorkloadCRDName   = "workloads.kueue.x-k8s.io"
+	KueueLocalQueueCRDName = "localqueues.kueue.x-k8s.io"
 )
@@ -33,6 +33,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -72,10 +73,12 @@ func init() {
 	utilruntime.Must(ray_v1.AddToScheme(scheme.Scheme))
 }
 
-func InitK8sResourceAccesser(config *rest.Config, clientset *kubernetes.Clientset, isDaemonMode bool) error {
+// InitK8sResourceAccesser initializes the accesser once, the cache of the daemon mode only serves the cacheNamespaces
+// if they are given, and the other namespaces are read from the api server
+func InitK8sResourceAccesser(config *rest.Config, clientset *kubernetes.Clientset, isDaemonMode bool, cacheNamespaces ...string) error {
 	var err error
 	once.Do(func() {
-		accesser, err = NewK8sResourceAccesser(config, clientset, isDaemonMode)
+		accesser, err = NewK8sResourceAccesser(config, clientset, isDaemonMode, cacheNamespaces...)
 		if err == nil {
 			err = accesser.Run()
 		}
@@ -91,9 +94,11 @@ type k8sResourceAccesser struct {
 	cacheClient  cache.Cache
 	clientset    *kubernetes.Clientset
 	cacheEnabled bool
+	// cacheNamespaces are the namespaces served by the cache, all the namespaces if it is empty
+	cacheNamespaces []string
 }
 
-func NewK8sResourceAccesser(config *rest.Config, clientset *kubernetes.Clientset, isDaemonMode bool) (*k8sResourceAccesser, error) {
+func NewK8sResourceAccesser(config *rest.Config, clientset *kubernetes.Clientset, isDaemonMode bool, cacheNamespaces ...string) (*k8sResourceAccesser, error) {
 	var cacheClient cache.Cache
 	var err error
 	if isDaemonMode {
@@ -108,7 +113,14 @@ func NewK8sResourceAccesser(config *rest.Config, clientset *kubernetes.Clientset
 			// if create dynamic mapper failed, use default restMapper
 			mapper = nil
 		}
-		cacheClient, err = cache.New(config, cache.Options{Mapper: mapper})
+		options := cache.Options{Mapper: mapper}
+		if len(cacheNamespaces) > 0 {
+			options.DefaultNamespaces = map[string]cache.Config{}
+			for _, namespace := range cacheNamespaces {
+				options.DefaultNamespaces[namespace] = cache.Config{}
+			}
+		}
+		cacheClient, err = cache.New(config, options)
 		if err != nil {
 			log.Errorf("failed to create cacheClient, reason: %v", err)
 			return nil, err
@@ -121,12 +133,26 @@ func NewK8sResourceAccesser(config *rest.Config, clientset *kubernetes.Clientset
 		})
 	}
 	return &k8sResourceAccesser{
-		cacheClient:  cacheClient,
-		clientset:    clientset,
-		cacheEnabled: isDaemonMode,
+		cacheClient:     cacheClient,
+		clientset:       clientset,
+		cacheEnabled:    isDaemonMode,
+		cacheNamespaces: cacheNamespaces,
 	}, err
 }
 
+// cachesNamespace reports whether the objects in the namespace are read from the cache
+func (k *k8sResourceAccesser) cachesNamespace(namespace string) bool {
+	if !k.cacheEnabled || len(k.cacheNamespaces) == 0 {
+		return k.cacheEnabled
+	}
+	for _, cached := range k.cacheNamespaces {
+		if cached == namespace {
+			return true
+		}
+	}
+	return false
+}
+
 func (k *k8sResourceAccesser) Run() (err error) {
 	if !k.cacheEnabled {
 		return nil
@@ -146,6 +172,19 @@ func (k *k8sResourceAccesser) GetCacheClient() cache.Cache {
 	return k.cacheClient
 }
 
+// AddEventHandler registers the handler to the informer of the object kind in the cache, it is only supported in daemon mode
+func (k *k8sResourceAccesser) AddEventHandler(ctx context.Context, obj client.Object, handler toolscache.ResourceEventHandler) error {
+	if !k.cacheEnabled {
+		return fmt.Errorf("the event handler of %T is only supported in daemon mode", obj)
+	}
+	informer, err := k.cacheClient.GetInformer(ctx, obj)
+	if err != nil {
+		return err
+	}
+	_, err = informer.AddEventHandler(handler)
+	return err
+}
+
 func (k *k8sResourceAccesser) ListPods(namespace string, filterLabels string, filterFields string, filterFunc func(*corev1.Pod) bool) ([]*corev1.Pod, error) {
 	pods := []*corev1.Pod{}
 	podList := &corev1.PodList{}
@@ -157,7 +196,7 @@ func (k *k8sResourceAccesser) ListPods(namespace string, filterLabels string, fi
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			podList,
@@ -196,7 +235,7 @@ func (k *k8sResourceAccesser) ListStatefulSets(namespace string, filterLabels st
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			stsList,
@@ -229,7 +268,7 @@ func (k *k8sResourceAccesser) ListDeployments(namespace string, filterLabels str
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			deployList,
@@ -262,7 +301,7 @@ func (k *k8sResourceAccesser) ListBatchJobs(namespace string, filterLabels strin
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			jobList,
@@ -295,7 +334,7 @@ func (k *k8sResourceAccesser) ListServices(namespace string, filterLabels string
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			serviceList,
@@ -328,7 +367,7 @@ func (k *k8sResourceAccesser) ListConfigMaps(namespace string, filterLabels stri
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			configmapList,
@@ -354,6 +393,27 @@ func (k *k8sResourceAccesser) ListConfigMaps(namespace string, filterLabels stri
 	return configmaps, nil
 }
 
+func (k *k8sResourceAccesser) ListEvents(namespace string) ([]*corev1.Event, error) {
+	events := []*corev1.Event{}
+	eventList := &corev1.EventList{}
+	var err error
+	if k.cachesNamespace(namespace) {
+		err = k.cacheClient.List(
+			context.Background(),
+			eventList,
+			client.InNamespace(namespace))
+	} else {
+		eventList, err = k.clientset.CoreV1().Events(namespace).List(context.TODO(), metav1.ListOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range eventList.Items {
+		events = append(events, event.DeepCopy())
+	}
+	return events, nil
+}
+
 func (k *k8sResourceAccesser) ListNodes(filterLabels string) ([]*corev1.Node, error) {
 	nodeList := &corev1.NodeList{}
 	nodes := []*corev1.Node{}
@@ -390,7 +450,7 @@ func (k *k8sResourceAccesser) ListCrons(cronClient *cronversioned.Clientset, nam
 	var crons []*cron_v1alpha1.Cron
 	cronList := &cron_v1alpha1.CronList{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			cronList,
@@ -418,7 +478,7 @@ func (k *k8sResourceAccesser) ListKServeInferenceService(ctx context.Context, ks
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			ctx,
 			jobList,
@@ -449,7 +509,7 @@ func (k *k8sResourceAccesser) ListTensorflowJobs(tfjobClient *tfversioned.Client
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			jobList,
@@ -481,7 +541,7 @@ func (k *k8sResourceAccesser) ListMPIJobs(mpijobClient *mpiversioned.Clientset,
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			jobList,
@@ -511,7 +571,7 @@ func (k *k8sResourceAccesser) ListPytorchJobs(pytorchjobClient *pyversioned.Clie
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			jobList,
@@ -541,7 +601,7 @@ func (k *k8sResourceAccesser) ListRayJobs(rayClient *rayversioned.Clientset, nam
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			jobList,
@@ -571,7 +631,7 @@ func (k *k8sResourceAccesser) ListETJobs(etjobClient *etversioned.Clientset, nam
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			jobList,
@@ -601,7 +661,7 @@ func (k *k8sResourceAccesser) ListVolcanoJobs(volcanojobClient *volcanovesioned.
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			jobList,
@@ -631,7 +691,7 @@ func (k *k8sResourceAccesser) ListSparkJobs(sparkjobClient *sparkversioned.Clien
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			jobList,
@@ -661,7 +721,7 @@ func (k *k8sResourceAccesser) ListLWSJobs(lwsClient *lwsversioned.Clientset, nam
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			jobList,
@@ -687,7 +747,7 @@ func (k *k8sResourceAccesser) ListLWSJobs(lwsClient *lwsversioned.Clientset, nam
 func (k *k8sResourceAccesser) GetCron(cronClient *cronversioned.Clientset, namespace string, name string) (*cron_v1alpha1.Cron, error) {
 	cron := &cron_v1alpha1.Cron{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, cron)
 		if err != nil {
 			return nil, fmt.Errorf("failed to find cron %v from cache, reason: %v", name, err)
@@ -704,7 +764,7 @@ func (k *k8sResourceAccesser) GetCron(cronClient *cronversioned.Clientset, names
 func (k *k8sResourceAccesser) GetTensorflowJob(tfjobClient *tfversioned.Clientset, namespace string, name string) (*tfv1.TFJob, error) {
 	tfjob := &tfv1.TFJob{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, tfjob)
 		if err != nil {
 			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, TensorflowCRDNameInDaemonMode, name)) {
@@ -727,7 +787,7 @@ func (k *k8sResourceAccesser) GetTensorflowJob(tfjobClient *tfversioned.Clientse
 func (k *k8sResourceAccesser) GetMPIJob(mpijobClient *mpiversioned.Clientset, namespace string, name string) (*v1alpha1.MPIJob, error) {
 	mpijob := &v1alpha1.MPIJob{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, mpijob)
 		if err != nil {
 			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, MPICRDNameInDaemonMode, name)) {
@@ -750,7 +810,7 @@ func (k *k8sResourceAccesser) GetMPIJob(mpijobClient *mpiversioned.Clientset, na
 func (k *k8sResourceAccesser) GetPytorchJob(pytorchjobClient *pyversioned.Clientset, namespace string, name string) (*pytorch_v1.PyTorchJob, error) {
 	pytorchjob := &pytorch_v1.PyTorchJob{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, pytorchjob)
 		if err != nil {
 			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, PytorchCRDNameInDaemonMode, name)) {
@@ -774,7 +834,7 @@ func (k *k8sResourceAccesser) GetPytorchJob(pytorchjobClient *pyversioned.Client
 func (k *k8sResourceAccesser) GetRayJob(rayClient *rayversioned.Clientset, namespace string, name string) (*ray_v1.RayJob, error) {
 	rayJob := &ray_v1.RayJob{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, rayJob)
 		if err != nil {
 			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, RayJobCRDNameInDaemonMode, name)) {
@@ -798,7 +858,7 @@ func (k *k8sResourceAccesser) GetRayJob(rayClient *rayversioned.Clientset, names
 func (k *k8sResourceAccesser) GetETJob(etjobClient *etversioned.Clientset, namespace string, name string) (*v1alpha12.TrainingJob, error) {
 	etjob := &v1alpha12.TrainingJob{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, etjob)
 		if err != nil {
 			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, ETCRDNameInDaemonMode, name)) {
@@ -821,7 +881,7 @@ func (k *k8sResourceAccesser) GetETJob(etjobClient *etversioned.Clientset, names
 func (k *k8sResourceAccesser) GetVolcanoJob(volcanojobClient *volcanovesioned.Clientset, namespace string, name string) (*volcano_v1alpha1.Job, error) {
 	volcanoJob := &volcano_v1alpha1.Job{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, volcanoJob)
 		if err != nil {
 			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, VolcanoCRDNameInDaemonMode, name)) {
@@ -844,7 +904,7 @@ func (k *k8sResourceAccesser) GetVolcanoJob(volcanojobClient *volcanovesioned.Cl
 func (k *k8sResourceAccesser) GetSparkJob(sparkjobClient *sparkversioned.Clientset, namespace string, name string) (*spark_v1beta2.SparkApplication, error) {
 	sparkJob := &spark_v1beta2.SparkApplication{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, sparkJob)
 		if err != nil {
 			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, SparkCRDNameInDaemonMode, name)) {
@@ -868,7 +928,7 @@ func (k *k8sResourceAccesser) GetSparkJob(sparkjobClient *sparkversioned.Clients
 func (k *k8sResourceAccesser) GetLWSJob(lwsClient *lwsversioned.Clientset, namespace string, name string) (*lws_v1.LeaderWorkerSet, error) {
 	lwsJob := &lws_v1.LeaderWorkerSet{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, lwsJob)
 		if err != nil {
 			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, LWSCRDNameInDaemonMode, name)) {
@@ -891,7 +951,7 @@ func (k *k8sResourceAccesser) GetLWSJob(lwsClient *lwsversioned.Clientset, names
 func (k *k8sResourceAccesser) GetService(namespace, name string) (*corev1.Service, error) {
 	service := &corev1.Service{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, service)
 	} else {
 		service, err = k.clientset.CoreV1().Services(namespace).Get(context.TODO(), name, metav1.GetOptions{})
@@ -905,7 +965,7 @@ func (k *k8sResourceAccesser) GetService(namespace, name string) (*corev1.Servic
 func (k *k8sResourceAccesser) GetEndpoints(namespace, name string) (*corev1.Endpoints, error) {
 	endpoints := &corev1.Endpoints{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, endpoints)
 	} else {
 		endpoints, err = k.clientset.CoreV1().Endpoints(namespace).Get(context.TODO(), name, metav1.GetOptions{})
@@ -933,7 +993,7 @@ func (k *k8sResourceAccesser) GetNode(nodeName string) (*corev1.Node, error) {
 func (k *k8sResourceAccesser) GetJob(name, namespace string) (*batchv1.Job, error) {
 	job := &batchv1.Job{}
 	var err error
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: namespace}, job)
 	} else {
 		job, err = k.clientset.BatchV1().Jobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
@@ -956,7 +1016,7 @@ func (k *k8sResourceAccesser) ListJobs(namespace string, filterLabels string, fi
 	if err != nil {
 		return nil, err
 	}
-	if k.cacheEnabled {
+	if k.cachesNamespace(namespace) {
 		err = k.cacheClient.List(
 			context.Background(),
 			jobList,
@@ -0,0 +1,291 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logarchive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	appwrapperv1beta2 "github.com/kubeflow/arena/pkg/operators/appwrapper-operator/apis/appwrapper/v1beta2"
+	volcanov1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+)
+
+const (
+	// appWrapperLabel is the label of the pods created by an AppWrapper
+	appWrapperLabel = "workload.codeflare.dev/appwrapper"
+	// releaseLabel is the label of the pods of the training jobs submitted by arena
+	releaseLabel = "release"
+	// appLabel is the label of the pods of the training jobs submitted by arena with the app of their chart
+	appLabel = "app"
+)
+
+// trainingJobApps are the app labels set by the charts of the training jobs, the charts are named
+// after the training type except tf-horovod
+var trainingJobApps = map[string]bool{
+	string(types.TFTrainingJob):        true,
+	string(types.MPITrainingJob):       true,
+	string(types.PytorchTrainingJob):   true,
+	"tf-horovod":                       true,
+	string(types.VolcanoTrainingJob):   true,
+	string(types.ETTrainingJob):        true,
+	string(types.SparkTrainingJob):     true,
+	string(types.DeepSpeedTrainingJob): true,
+	string(types.RayJob):               true,
+	string(types.AppWrapperJob):        true,
+}
+
+// attemptSource is the job resource which counts the attempts of the job
+type attemptSource struct {
+	resource schema.GroupVersionResource
+	field    []string
+}
+
+var (
+	// the AppWrapper counts its resets in status.resettingCount
+	appWrapperAttempts = attemptSource{
+		resource: appwrapperv1beta2.SchemeGroupVersion.WithResource("appwrappers"),
+		field:    []string{"status", "resettingCount"},
+	}
+	// the volcano job counts its restarts in status.retryCount
+	volcanoJobAttempts = attemptSource{
+		resource: volcanov1alpha1.SchemeGroupVersion.WithResource("jobs"),
+		field:    []string{"status", "retryCount"},
+	}
+)
+
+// Archiver watches the pods of the training jobs and archives the logs of their containers by attempt, so
+// the logs of the pods deleted by AppWrapper resets and volcano job retries can still be read. The original
+// run is attempt 0, the attempt of a volcano job pod is the job version it is created in, and the attempt of
+// an AppWrapper pod is the reset count of the AppWrapper when the pod is seen first. The pods which finished
+// or are being deleted before the archiver started are not archived since their attempt is unknown
+type Archiver struct {
+	clientset kubernetes.Interface
+	dynamic   dynamic.Interface
+	store     *Store
+	namespace string
+	started   time.Time
+
+	lock sync.Mutex
+	// attempts is the attempt of the pods by pod uid, -1 if the pod is not archived
+	attempts map[string]int
+	// archived is the files which are archived or being archived by pod uid
+	archived map[string]map[string]bool
+}
+
+// NewArchiver creates an archiver of the pods in the namespace, all the namespaces are watched if it is empty
+func NewArchiver(clientset kubernetes.Interface, dynamicClient dynamic.Interface, store *Store, namespace string) *Archiver {
+	return &Archiver{
+		clientset: clientset,
+		dynamic:   dynamicClient,
+		store:     store,
+		namespace: namespace,
+		started:   time.Now(),
+		attempts:  map[string]int{},
+		archived:  map[string]map[string]bool{},
+	}
+}
+
+// Run archives the logs until the context is done
+func (a *Archiver) Run(ctx context.Context) error {
+	factory := informers.NewSharedInformerFactoryWithOptions(a.clientset, 0, informers.WithNamespace(a.namespace))
+	informer := factory.Core().V1().Pods().Informer()
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if pod, ok := obj.(*corev1.Pod); ok {
+				a.archivePod(ctx, pod)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if pod, ok := newObj.(*corev1.Pod); ok {
+				a.archivePod(ctx, pod)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if pod, ok := obj.(*corev1.Pod); ok {
+				a.forget(pod)
+			}
+		},
+	})
+	if err != nil {
+		return err
+	}
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return fmt.Errorf("failed to sync the pods")
+	}
+	<-ctx.Done()
+	factory.Shutdown()
+	return nil
+}
+
+// archivePod starts archiving the containers of the pod which are not archived yet. The logs of a running
+// container are followed until it is terminated, the logs of a terminated container are fetched once
+func (a *Archiver) archivePod(ctx context.Context, pod *corev1.Pod) {
+	job, source := jobOfPod(pod)
+	if job == "" {
+		return
+	}
+	attempt := a.attemptOf(ctx, pod, job, source)
+	if attempt < 0 {
+		return
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		entry := Entry{
+			Namespace:    pod.Namespace,
+			Job:          job,
+			Attempt:      attempt,
+			Pod:          pod.Name,
+			PodUID:       string(pod.UID),
+			Container:    status.Name,
+			RestartCount: status.RestartCount,
+		}
+		switch {
+		case status.State.Running != nil:
+			a.archive(ctx, entry, &corev1.PodLogOptions{Container: status.Name, Follow: true})
+		case status.State.Terminated != nil:
+			a.archive(ctx, entry, &corev1.PodLogOptions{Container: status.Name})
+		}
+		// the previous instance of the container is terminated before it is seen
+		if status.LastTerminationState.Terminated != nil && status.RestartCount > 0 {
+			entry.RestartCount = status.RestartCount - 1
+			a.archive(ctx, entry, &corev1.PodLogOptions{Container: status.Name, Previous: true})
+		}
+	}
+}
+
+// archive copies the logs of the entry to the store in the background if it is not archived yet
+func (a *Archiver) archive(ctx context.Context, entry Entry, options *corev1.PodLogOptions) {
+	file := fmt.Sprintf("%v_%v", entry.Container, entry.RestartCount)
+	a.lock.Lock()
+	if a.archived[entry.PodUID][file] {
+		a.lock.Unlock()
+		return
+	}
+	if a.archived[entry.PodUID] == nil {
+		a.archived[entry.PodUID] = map[string]bool{}
+	}
+	a.archived[entry.PodUID][file] = true
+	a.lock.Unlock()
+
+	go func() {
+		if err := a.copyLogs(ctx, entry, options); err != nil {
+			log.Warnf("failed to archive the logs of %v/%v container %v: %v", entry.Namespace, entry.Pod, entry.Container, err)
+			return
+		}
+		log.Debugf("archived the logs of %v/%v container %v in attempt %v", entry.Namespace, entry.Pod, entry.Container, entry.Attempt)
+	}()
+}
+
+func (a *Archiver) copyLogs(ctx context.Context, entry Entry, options *corev1.PodLogOptions) error {
+	reader, err := a.clientset.CoreV1().Pods(entry.Namespace).GetLogs(entry.Pod, options).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	w, err := a.store.Create(entry)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, reader)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// attemptOf returns the attempt of the pod, it is resolved when the pod is seen first and -1 if it is unknown
+func (a *Archiver) attemptOf(ctx context.Context, pod *corev1.Pod, job string, source *attemptSource) int {
+	uid := string(pod.UID)
+	a.lock.Lock()
+	attempt, ok := a.attempts[uid]
+	a.lock.Unlock()
+	if ok {
+		return attempt
+	}
+	attempt = a.resolveAttempt(ctx, pod, job, source)
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.attempts[uid] = attempt
+	return attempt
+}
+
+func (a *Archiver) resolveAttempt(ctx context.Context, pod *corev1.Pod, job string, source *attemptSource) int {
+	if source == nil {
+		return 0
+	}
+	// volcano creates the pods of a restarted job in the next job version
+	if source == &volcanoJobAttempts {
+		if version, err := strconv.Atoi(pod.Annotations[volcanov1alpha1.JobVersion]); err == nil && version >= 0 {
+			return version
+		}
+	}
+	// the pods of the previous attempts may still be seen after the job is reset, the current count is only
+	// the attempt of the pods created since the archiver started or still running
+	if pod.CreationTimestamp.Time.Before(a.started) && (pod.DeletionTimestamp != nil || podFinished(pod)) {
+		log.Debugf("skip archiving the logs of %v/%v, it finished before the archiver started", pod.Namespace, pod.Name)
+		return -1
+	}
+	obj, err := a.dynamic.Resource(source.resource).Namespace(pod.Namespace).Get(ctx, job, metav1.GetOptions{})
+	if err != nil {
+		log.Warnf("failed to get the %v %v/%v, archive the logs of %v in attempt 0: %v", source.resource.Resource, pod.Namespace, job, pod.Name, err)
+		return 0
+	}
+	count, _, _ := unstructured.NestedInt64(obj.Object, source.field...)
+	return int(count)
+}
+
+func podFinished(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
+
+func (a *Archiver) forget(pod *corev1.Pod) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.attempts, string(pod.UID))
+	delete(a.archived, string(pod.UID))
+}
+
+// jobOfPod returns the training job of the pod and the resource counting its attempts,
+// the job is empty if the pod is not created by a training job submitted by arena
+func jobOfPod(pod *corev1.Pod) (string, *attemptSource) {
+	if !trainingJobApps[pod.Labels[appLabel]] || pod.Labels[releaseLabel] == "" {
+		return "", nil
+	}
+	if name := pod.Labels[appWrapperLabel]; name != "" {
+		return name, &appWrapperAttempts
+	}
+	if name := pod.Labels[volcanov1alpha1.JobNameKey]; name != "" {
+		return name, &volcanoJobAttempts
+	}
+	return pod.Labels[releaseLabel], nil
+}
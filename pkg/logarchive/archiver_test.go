@@ -0,0 +1,250 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logarchive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newAppWrapper(name string, retries int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "workload.codeflare.dev/v1beta2",
+		"kind":       "AppWrapper",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		"status":     map[string]interface{}{"resettingCount": retries},
+	}}
+}
+
+func TestArchivePod(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-worker-0",
+			Namespace: "default",
+			UID:       "0123456789abcdef",
+			Labels:    map[string]string{appWrapperLabel: "test", appLabel: "appwrapperjob", releaseLabel: "test"},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "worker",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}},
+			}},
+		},
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{appWrapperAttempts.resource: "AppWrapperList"},
+		newAppWrapper("test", 2))
+	store := NewStore(t.TempDir())
+	archiver := NewArchiver(fake.NewSimpleClientset(pod), dynamicClient, store, "default")
+	archiver.archivePod(context.Background(), pod)
+
+	expected := "[test-worker-0/worker] fake logs\n"
+	var out bytes.Buffer
+	deadline := time.Now().Add(5 * time.Second)
+	for out.String() != expected && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		out.Reset()
+		_ = store.WriteLogs(&out, "default", "test", 2, "")
+	}
+	if out.String() != expected {
+		t.Fatalf("expected the logs to be archived in attempt 2 as %q, got %q", expected, out.String())
+	}
+	// the terminated container is archived once
+	archiver.archivePod(context.Background(), pod)
+	archiver.lock.Lock()
+	archived := len(archiver.archived[string(pod.UID)])
+	archiver.lock.Unlock()
+	if archived != 1 {
+		t.Errorf("expected the container to be archived once, got %v times", archived)
+	}
+
+	server := httptest.NewServer(NewHandler(store))
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/attempts/default/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	attempts := []int{}
+	if err := json.NewDecoder(resp.Body).Decode(&attempts); err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if !reflect.DeepEqual(attempts, []int{2}) {
+		t.Errorf("expected the attempts [2], got %v", attempts)
+	}
+	for path, code := range map[string]int{
+		"/logs/default/test/2?instance=test-worker-0": http.StatusOK,
+		"/logs/default/test/0":                        http.StatusNotFound,
+		"/logs/default/test/2?instance=test-worker-1": http.StatusNotFound,
+		"/logs/default/test/2?instance=..":            http.StatusBadRequest,
+	} {
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != code {
+			t.Errorf("expected GET %v to return %v, got %v", path, code, resp.StatusCode)
+		}
+	}
+}
+
+func TestAttemptOf(t *testing.T) {
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{appWrapperAttempts.resource: "AppWrapperList", volcanoJobAttempts.resource: "JobList"},
+		newAppWrapper("test", 2))
+	archiver := NewArchiver(fake.NewSimpleClientset(), dynamicClient, NewStore(t.TempDir()), "default")
+	before := metav1.NewTime(archiver.started.Add(-time.Hour))
+	after := metav1.NewTime(archiver.started.Add(time.Second))
+	testcases := []struct {
+		name     string
+		pod      *corev1.Pod
+		job      string
+		source   *attemptSource
+		expected int
+	}{
+		{
+			name:     "the job version of a volcano job pod",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{UID: "1", CreationTimestamp: before, Annotations: map[string]string{"volcano.sh/job-version": "3"}}},
+			job:      "vj",
+			source:   &volcanoJobAttempts,
+			expected: 3,
+		},
+		{
+			name:     "the reset count for a pod created after the archiver started",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{UID: "2", CreationTimestamp: after}, Status: corev1.PodStatus{Phase: corev1.PodFailed}},
+			job:      "test",
+			source:   &appWrapperAttempts,
+			expected: 2,
+		},
+		{
+			name:     "the reset count for a running pod",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{UID: "3", CreationTimestamp: before}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			job:      "test",
+			source:   &appWrapperAttempts,
+			expected: 2,
+		},
+		{
+			name:     "a pod finished before the archiver started",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{UID: "4", CreationTimestamp: before}, Status: corev1.PodStatus{Phase: corev1.PodFailed}},
+			job:      "test",
+			source:   &appWrapperAttempts,
+			expected: -1,
+		},
+		{
+			name:     "a pod being deleted before the archiver started",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{UID: "5", CreationTimestamp: before, DeletionTimestamp: &before}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			job:      "test",
+			source:   &appWrapperAttempts,
+			expected: -1,
+		},
+		{
+			name: "the pods of the other jobs are in attempt 0",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{UID: "6", CreationTimestamp: before}, Status: corev1.PodStatus{Phase: corev1.PodFailed}},
+			job:  "tf",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.pod.Namespace = "default"
+			if attempt := archiver.attemptOf(context.Background(), tc.pod, tc.job, tc.source); attempt != tc.expected {
+				t.Errorf("expected attempt %v, got %v", tc.expected, attempt)
+			}
+		})
+	}
+}
+
+func TestJobOfPod(t *testing.T) {
+	testcases := []struct {
+		labels   map[string]string
+		job      string
+		attempts *attemptSource
+	}{
+		{labels: map[string]string{appWrapperLabel: "aw", appLabel: "appwrapperjob", releaseLabel: "aw"}, job: "aw", attempts: &appWrapperAttempts},
+		{labels: map[string]string{"volcano.sh/job-name": "vj", appLabel: "volcanojob", releaseLabel: "vj"}, job: "vj", attempts: &volcanoJobAttempts},
+		{labels: map[string]string{appLabel: "tfjob", releaseLabel: "tf"}, job: "tf"},
+		{labels: map[string]string{appLabel: "tf-horovod", releaseLabel: "hvd"}, job: "hvd"},
+		{labels: map[string]string{appLabel: "nginx", releaseLabel: "nginx"}},
+		{labels: map[string]string{releaseLabel: "tf"}},
+		{labels: map[string]string{appLabel: "tfjob"}},
+		{labels: map[string]string{appWrapperLabel: "aw"}},
+		{labels: map[string]string{"volcano.sh/job-name": "vj"}},
+	}
+	for _, tc := range testcases {
+		job, attempts := jobOfPod(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: tc.labels}})
+		if job != tc.job || attempts != tc.attempts {
+			t.Errorf("expected the job of the pod with labels %v to be %q, got %q", tc.labels, tc.job, job)
+		}
+	}
+}
+
+func TestStorePrune(t *testing.T) {
+	root := t.TempDir()
+	store := NewStore(root)
+	write := func(job string, attempt int, size int, modified time.Time) {
+		entry := Entry{Namespace: "default", Job: job, Attempt: attempt, Pod: job + "-0", PodUID: "uid", Container: "main"}
+		w, err := store.Create(entry)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(bytes.Repeat([]byte("x"), size)); err != nil {
+			t.Fatal(err)
+		}
+		w.Close()
+		path, _ := store.path(entry)
+		for _, p := range []string{path, filepath.Dir(path), filepath.Dir(filepath.Dir(path))} {
+			if err := os.Chtimes(p, modified, modified); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	now := time.Now()
+	write("expired", 0, 10, now.Add(-48*time.Hour))
+	write("old", 0, 100, now.Add(-2*time.Hour))
+	write("old", 1, 100, now.Add(-time.Hour))
+	write("new", 0, 100, now)
+
+	if err := store.Prune(24*time.Hour, 250); err != nil {
+		t.Fatal(err)
+	}
+	for job, expected := range map[string][]int{"expired": {}, "old": {1}, "new": {0}} {
+		attempts, err := store.Attempts("default", job)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(attempts, expected) {
+			t.Errorf("expected the attempts %v of job %v to be kept, got %v", expected, job, attempts)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "default", "expired")); !os.IsNotExist(err) {
+		t.Errorf("expected the directory of the pruned job to be removed, got %v", err)
+	}
+}
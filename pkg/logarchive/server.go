@@ -0,0 +1,84 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logarchive
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ServiceName is the name of the service of the log archiver in the arena namespace
+	ServiceName = "arena-log-archiver"
+	// ServicePort is the port of the service of the log archiver
+	ServicePort = "8080"
+	// ReaderRoleName is the role in the arena namespace which allows to get services/proxy of the log archiver,
+	// the archived logs are read through the service proxy of the api server
+	ReaderRoleName = "arena-log-archiver-reader"
+)
+
+// NewHandler serves the archived logs of the store, the users are authorized by the api server which only proxies
+// the requests of the users bound to ReaderRoleName:
+//
+//	GET /attempts/<namespace>/<job>                            the archived attempts in json
+//	GET /logs/<namespace>/<job>/<attempt>?instance=<pod name>  the logs of the attempt
+func NewHandler(store *Store) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/attempts/", func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/attempts/"), "/")
+		if len(parts) != 2 {
+			http.NotFound(w, r)
+			return
+		}
+		attempts, err := store.Attempts(parts[0], parts[1])
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(attempts)
+	})
+	mux.HandleFunc("/logs/", func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/logs/"), "/")
+		if len(parts) != 3 {
+			http.NotFound(w, r)
+			return
+		}
+		attempt, err := strconv.Atoi(parts[2])
+		if err != nil || attempt < 0 {
+			http.Error(w, "invalid attempt "+parts[2], http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if err := store.WriteLogs(w, parts[0], parts[1], attempt, r.URL.Query().Get("instance")); err != nil {
+			writeError(w, err)
+		}
+	})
+	return mux
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidName):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, ErrAttemptNotFound), errors.Is(err, ErrInstanceNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
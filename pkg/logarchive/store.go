@@ -0,0 +1,232 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logarchive
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrAttemptNotFound  = errors.New("the attempt of the training job is not archived")
+	ErrInstanceNotFound = errors.New("the instance is not archived in the attempt")
+	ErrInvalidName      = errors.New("invalid name")
+)
+
+// Entry is the archived log of a container of a pod in an attempt of a training job
+type Entry struct {
+	Namespace    string
+	Job          string
+	Attempt      int
+	Pod          string
+	PodUID       string
+	Container    string
+	RestartCount int32
+}
+
+// Store keeps the archived logs in a directory, e.g. a mounted PVC. The files are laid out like the keys
+// of an object store: <namespace>/<job>/<attempt>/<pod>/<container>_<restart count>_<pod uid>.log
+type Store struct {
+	root string
+}
+
+func NewStore(root string) *Store {
+	return &Store{root: root}
+}
+
+// Create creates or truncates the file of the entry
+func (s *Store) Create(entry Entry) (io.WriteCloser, error) {
+	path, err := s.path(entry)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	return os.Create(path)
+}
+
+// Attempts returns the archived attempts of the training job in ascending order
+func (s *Store) Attempts(namespace, job string) ([]int, error) {
+	if !validName(namespace) || !validName(job) {
+		return nil, ErrInvalidName
+	}
+	items, err := os.ReadDir(filepath.Join(s.root, namespace, job))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	attempts := []int{}
+	for _, item := range items {
+		attempt, err := strconv.Atoi(item.Name())
+		if err == nil && item.IsDir() {
+			attempts = append(attempts, attempt)
+		}
+	}
+	sort.Ints(attempts)
+	return attempts, nil
+}
+
+// WriteLogs writes the archived logs of the attempt with the [pod/container] prefix, the logs are limited to
+// the pod if it is set. The logs of a pod are ordered by pod name and then by the time they are archived
+func (s *Store) WriteLogs(w io.Writer, namespace, job string, attempt int, pod string) error {
+	if !validName(namespace) || !validName(job) || (pod != "" && !validName(pod)) {
+		return ErrInvalidName
+	}
+	dir := filepath.Join(s.root, namespace, job, strconv.Itoa(attempt))
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return ErrAttemptNotFound
+	}
+	pods := []string{pod}
+	if pod == "" {
+		items, err := os.ReadDir(dir)
+		if err != nil {
+			return err
+		}
+		pods = []string{}
+		for _, item := range items {
+			pods = append(pods, item.Name())
+		}
+	}
+	for _, pod := range pods {
+		files, err := os.ReadDir(filepath.Join(dir, pod))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf("%w: %v", ErrInstanceNotFound, pod)
+			}
+			return err
+		}
+		entries := []os.FileInfo{}
+		for _, file := range files {
+			info, err := file.Info()
+			if err == nil {
+				entries = append(entries, info)
+			}
+		}
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].ModTime().Before(entries[j].ModTime())
+		})
+		for _, entry := range entries {
+			container := entry.Name()[:strings.Index(entry.Name(), "_")]
+			if err := writePrefixedFile(w, filepath.Join(dir, pod, entry.Name()), fmt.Sprintf("[%v/%v] ", pod, container)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Prune removes the archived attempts which are not written for maxAge, and then the least recently written
+// attempts until the archive is not larger than maxSize bytes. A limit is disabled if it is zero
+func (s *Store) Prune(maxAge time.Duration, maxSize int64) error {
+	type attemptDir struct {
+		path     string
+		size     int64
+		modified time.Time
+	}
+	dirs, err := filepath.Glob(filepath.Join(s.root, "*", "*", "*"))
+	if err != nil {
+		return err
+	}
+	attempts := []attemptDir{}
+	var total int64
+	for _, dir := range dirs {
+		attempt := attemptDir{path: dir}
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				attempt.size += info.Size()
+			}
+			if info.ModTime().After(attempt.modified) {
+				attempt.modified = info.ModTime()
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		total += attempt.size
+		attempts = append(attempts, attempt)
+	}
+	sort.Slice(attempts, func(i, j int) bool {
+		return attempts[i].modified.Before(attempts[j].modified)
+	})
+	for _, attempt := range attempts {
+		expired := maxAge > 0 && time.Since(attempt.modified) > maxAge
+		oversized := maxSize > 0 && total > maxSize
+		if !expired && !oversized {
+			break
+		}
+		if err := os.RemoveAll(attempt.path); err != nil {
+			return err
+		}
+		total -= attempt.size
+		// remove the directories of the job and the namespace once they are empty
+		_ = os.Remove(filepath.Dir(attempt.path))
+		_ = os.Remove(filepath.Dir(filepath.Dir(attempt.path)))
+	}
+	return nil
+}
+
+func (s *Store) path(entry Entry) (string, error) {
+	for _, name := range []string{entry.Namespace, entry.Job, entry.Pod, entry.Container, entry.PodUID} {
+		if !validName(name) {
+			return "", ErrInvalidName
+		}
+	}
+	uid := entry.PodUID
+	if len(uid) > 8 {
+		uid = uid[:8]
+	}
+	file := fmt.Sprintf("%v_%v_%v.log", entry.Container, entry.RestartCount, uid)
+	return filepath.Join(s.root, entry.Namespace, entry.Job, strconv.Itoa(entry.Attempt), entry.Pod, file), nil
+}
+
+func writePrefixedFile(w io.Writer, path, prefix string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			if _, werr := fmt.Fprintf(w, "%v%v\n", prefix, strings.TrimSuffix(line, "\n")); werr != nil {
+				return werr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// validName reports whether the name can be used as a single path element
+func validName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
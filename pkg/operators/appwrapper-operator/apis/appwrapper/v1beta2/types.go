@@ -15,13 +15,14 @@
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
-// +resource:path=appwrapper
+// +resource:path=appwrappers
 
 // AppWrapper is the Schema for the appwrappers API
 type AppWrapper struct {
@@ -86,7 +87,7 @@ type AppWrapperPodSetInfo struct {
 
 	// Tolerations to be merged into the pod template
 	// +optional
-	Tolerations []string `json:"tolerations,omitempty"`
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
 
 	// Annotations to be merged into the pod template
 	// +optional
@@ -125,6 +126,10 @@ type AppWrapperComponentStatus struct {
 	// Kind of the component
 	Kind string `json:"kind,omitempty"`
 
+	// PodSets are the pod sets of the deployed component
+	// +optional
+	PodSets []AppWrapperPodSet `json:"podSets,omitempty"`
+
 	// Status conditions of the component
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -168,8 +173,14 @@ const (
 	AppWrapperConditionDeletingResources = "DeletingResources"
 )
 
+const (
+	// RetryLimitAnnotation overrides the maximum number of retries of the AppWrapper
+	RetryLimitAnnotation = "workload.codeflare.dev.appwrapper/retryLimit"
+	// DefaultRetryLimit is the retry limit used by the AppWrapper controller if not annotated
+	DefaultRetryLimit int32 = 3
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
-// +resource:path=appwrappers
 
 // AppWrapperList contains a list of AppWrapper
 type AppWrapperList struct {
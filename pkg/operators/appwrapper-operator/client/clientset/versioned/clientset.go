@@ -12,206 +12,107 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// Code generated by client-gen. DO NOT EDIT.
+
 package versioned
 
 import (
-	"context"
-
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/rest"
+	"fmt"
+	"net/http"
 
-	appwrapperv1beta2 "github.com/kubeflow/arena/pkg/operators/appwrapper-operator/apis/appwrapper/v1beta2"
+	workloadv1beta2 "github.com/kubeflow/arena/pkg/operators/appwrapper-operator/client/clientset/versioned/typed/appwrapper/v1beta2"
+	discovery "k8s.io/client-go/discovery"
+	rest "k8s.io/client-go/rest"
+	flowcontrol "k8s.io/client-go/util/flowcontrol"
 )
 
-// AppWrapperV1beta2Interface defines the interface for AppWrapper v1beta2 API
-type AppWrapperV1beta2Interface interface {
-	AppWrappers(namespace string) AppWrapperInterface
-}
-
-// AppWrapperInterface defines the interface for operating on AppWrapper resources
-type AppWrapperInterface interface {
-	Get(name string, options metav1.GetOptions) (*appwrapperv1beta2.AppWrapper, error)
-	List(options metav1.ListOptions) (*appwrapperv1beta2.AppWrapperList, error)
-	Create(appwrapper *appwrapperv1beta2.AppWrapper) (*appwrapperv1beta2.AppWrapper, error)
-	Delete(name string, options *metav1.DeleteOptions) error
+type Interface interface {
+	Discovery() discovery.DiscoveryInterface
+	WorkloadV1beta2() workloadv1beta2.WorkloadV1beta2Interface
 }
 
-// Clientset is the client for AppWrapper resources
+// Clientset contains the clients for groups.
 type Clientset struct {
-	dynamicClient dynamic.Interface
+	*discovery.DiscoveryClient
+	workloadV1beta2 *workloadv1beta2.WorkloadV1beta2Client
 }
 
-// NewForConfig creates a new Clientset for the given config
-func NewForConfig(config *rest.Config) (*Clientset, error) {
-	dynamicClient, err := dynamic.NewForConfig(config)
-	if err != nil {
-		return nil, err
-	}
-	return &Clientset{dynamicClient: dynamicClient}, nil
+// WorkloadV1beta2 retrieves the WorkloadV1beta2Client
+func (c *Clientset) WorkloadV1beta2() workloadv1beta2.WorkloadV1beta2Interface {
+	return c.workloadV1beta2
 }
 
-// NewForConfigOrDie creates a new Clientset for the given config and panics on error
-func NewForConfigOrDie(config *rest.Config) *Clientset {
-	client, err := NewForConfig(config)
-	if err != nil {
-		panic(err)
+// Discovery retrieves the DiscoveryClient
+func (c *Clientset) Discovery() discovery.DiscoveryInterface {
+	if c == nil {
+		return nil
 	}
-	return client
-}
-
-// WorkloadV1beta2 returns the AppWrapperV1beta2Interface
-func (c *Clientset) WorkloadV1beta2() AppWrapperV1beta2Interface {
-	return &appWrapperV1beta2Client{dynamicClient: c.dynamicClient}
+	return c.DiscoveryClient
 }
 
-type appWrapperV1beta2Client struct {
-	dynamicClient dynamic.Interface
-}
+// NewForConfig creates a new Clientset for the given config.
+// If config's RateLimiter is not set and QPS and Burst are acceptable,
+// NewForConfig will generate a rate-limiter in configShallowCopy.
+// NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewForConfig(c *rest.Config) (*Clientset, error) {
+	configShallowCopy := *c
 
-func (c *appWrapperV1beta2Client) AppWrappers(namespace string) AppWrapperInterface {
-	return &appWrappers{
-		dynamicClient: c.dynamicClient,
-		namespace:     namespace,
+	if configShallowCopy.UserAgent == "" {
+		configShallowCopy.UserAgent = rest.DefaultKubernetesUserAgent()
 	}
-}
 
-type appWrappers struct {
-	dynamicClient dynamic.Interface
-	namespace     string
-}
-
-var appWrapperGVR = schema.GroupVersionResource{
-	Group:    "workload.codeflare.dev",
-	Version:  "v1beta2",
-	Resource: "appwrappers",
-}
-
-func (a *appWrappers) Get(name string, options metav1.GetOptions) (*appwrapperv1beta2.AppWrapper, error) {
-	unstructuredObj, err := a.dynamicClient.Resource(appWrapperGVR).Namespace(a.namespace).Get(context.TODO(), name, options)
+	// share the transport between all clients
+	httpClient, err := rest.HTTPClientFor(&configShallowCopy)
 	if err != nil {
 		return nil, err
 	}
-	return convertToAppWrapper(unstructuredObj)
-}
 
-func (a *appWrappers) List(options metav1.ListOptions) (*appwrapperv1beta2.AppWrapperList, error) {
-	unstructuredList, err := a.dynamicClient.Resource(appWrapperGVR).Namespace(a.namespace).List(context.TODO(), options)
-	if err != nil {
-		return nil, err
-	}
-	return convertToAppWrapperList(unstructuredList)
+	return NewForConfigAndClient(&configShallowCopy, httpClient)
 }
 
-func (a *appWrappers) Create(appwrapper *appwrapperv1beta2.AppWrapper) (*appwrapperv1beta2.AppWrapper, error) {
-	unstructuredObj, err := convertFromAppWrapper(appwrapper)
-	if err != nil {
-		return nil, err
-	}
-	created, err := a.dynamicClient.Resource(appWrapperGVR).Namespace(a.namespace).Create(context.TODO(), unstructuredObj, metav1.CreateOptions{})
-	if err != nil {
-		return nil, err
+// NewForConfigAndClient creates a new Clientset for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+// If config's RateLimiter is not set and QPS and Burst are acceptable,
+// NewForConfigAndClient will generate a rate-limiter in configShallowCopy.
+func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset, error) {
+	configShallowCopy := *c
+	if configShallowCopy.RateLimiter == nil && configShallowCopy.QPS > 0 {
+		if configShallowCopy.Burst <= 0 {
+			return nil, fmt.Errorf("burst is required to be greater than 0 when RateLimiter is not set and QPS is set to greater than 0")
+		}
+		configShallowCopy.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(configShallowCopy.QPS, configShallowCopy.Burst)
 	}
-	return convertToAppWrapper(created)
-}
 
-func (a *appWrappers) Delete(name string, options *metav1.DeleteOptions) error {
-	return a.dynamicClient.Resource(appWrapperGVR).Namespace(a.namespace).Delete(context.TODO(), name, *options)
-}
-
-// convertToAppWrapper converts an unstructured object to AppWrapper
-func convertToAppWrapper(obj *unstructured.Unstructured) (*appwrapperv1beta2.AppWrapper, error) {
-	aw := &appwrapperv1beta2.AppWrapper{}
-	aw.Name = obj.GetName()
-	aw.Namespace = obj.GetNamespace()
-	aw.UID = obj.GetUID()
-	aw.Labels = obj.GetLabels()
-	aw.Annotations = obj.GetAnnotations()
-	aw.CreationTimestamp = obj.GetCreationTimestamp()
-
-	// Parse spec
-	spec, found, err := unstructured.NestedMap(obj.Object, "spec")
+	var cs Clientset
+	var err error
+	cs.workloadV1beta2, err = workloadv1beta2.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
 	}
-	if found {
-		if suspend, ok, _ := unstructured.NestedBool(spec, "suspend"); ok {
-			aw.Spec.Suspend = suspend
-		}
-		// Parse components
-		if components, ok, _ := unstructured.NestedSlice(spec, "components"); ok {
-			for range components {
-				aw.Spec.Components = append(aw.Spec.Components, appwrapperv1beta2.AppWrapperComponent{})
-			}
-		}
-	}
 
-	// Parse status
-	status, found, err := unstructured.NestedMap(obj.Object, "status")
+	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
 	}
-	if found {
-		if phase, ok, _ := unstructured.NestedString(status, "phase"); ok {
-			aw.Status.Phase = appwrapperv1beta2.AppWrapperPhase(phase)
-		}
-		if retries, ok, _ := unstructured.NestedInt64(status, "resettingCount"); ok {
-			aw.Status.Retries = int32(retries)
-		}
-	}
-
-	return aw, nil
+	return &cs, nil
 }
 
-// convertToAppWrapperList converts an unstructured list to AppWrapperList
-func convertToAppWrapperList(list *unstructured.UnstructuredList) (*appwrapperv1beta2.AppWrapperList, error) {
-	awList := &appwrapperv1beta2.AppWrapperList{}
-	for _, item := range list.Items {
-		aw, err := convertToAppWrapper(&item)
-		if err != nil {
-			return nil, err
-		}
-		awList.Items = append(awList.Items, *aw)
+// NewForConfigOrDie creates a new Clientset for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *Clientset {
+	cs, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
 	}
-	return awList, nil
+	return cs
 }
 
-// convertFromAppWrapper converts AppWrapper to unstructured object
-func convertFromAppWrapper(aw *appwrapperv1beta2.AppWrapper) (*unstructured.Unstructured, error) {
-	obj := &unstructured.Unstructured{}
-	obj.SetAPIVersion("workload.codeflare.dev/v1beta2")
-	obj.SetKind("AppWrapper")
-	obj.SetName(aw.Name)
-	obj.SetNamespace(aw.Namespace)
-	obj.SetLabels(aw.Labels)
-	obj.SetAnnotations(aw.Annotations)
-
-	// Set spec
-	spec := map[string]interface{}{
-		"suspend": aw.Spec.Suspend,
-	}
-
-	// Convert components
-	components := make([]interface{}, 0, len(aw.Spec.Components))
-	for _, comp := range aw.Spec.Components {
-		compMap := map[string]interface{}{}
-		if comp.Annotations != nil {
-			compMap["annotations"] = comp.Annotations
-		}
-		if comp.Template.Raw != nil {
-			compMap["template"] = comp.Template.Raw
-		}
-		components = append(components, compMap)
-	}
-	if len(components) > 0 {
-		spec["components"] = components
-	}
-
-	if err := unstructured.SetNestedMap(obj.Object, spec, "spec"); err != nil {
-		return nil, err
-	}
+// New creates a new Clientset for the given RESTClient.
+func New(c rest.Interface) *Clientset {
+	var cs Clientset
+	cs.workloadV1beta2 = workloadv1beta2.New(c)
 
-	return obj, nil
+	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
+	return &cs
 }
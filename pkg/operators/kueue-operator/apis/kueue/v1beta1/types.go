@@ -0,0 +1,175 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// QueueNameLabel is the label key of the LocalQueue a job is submitted to
+	QueueNameLabel = "kueue.x-k8s.io/queue-name"
+	// JobUIDLabel is the label key of the uid of the job owning a Workload
+	JobUIDLabel = "kueue.x-k8s.io/job-uid"
+
+	// WorkloadQuotaReserved means that the Workload has reserved quota in a ClusterQueue
+	WorkloadQuotaReserved = "QuotaReserved"
+	// WorkloadAdmitted means that the Workload has reserved quota and all the admission checks passed
+	WorkloadAdmitted = "Admitted"
+	// WorkloadPodsReady means that all the pods of the Workload are ready
+	WorkloadPodsReady = "PodsReady"
+	// WorkloadEvicted means that the Workload was evicted by a ClusterQueue
+	WorkloadEvicted = "Evicted"
+	// WorkloadPreempted means that the Workload was preempted
+	WorkloadPreempted = "Preempted"
+	// WorkloadFinished means that the Workload has finished
+	WorkloadFinished = "Finished"
+)
+
+// ClusterQueueReference is the name of the ClusterQueue.
+type ClusterQueueReference string
+
+// ResourceFlavorReference is the name of the ResourceFlavor.
+type ResourceFlavorReference string
+
+// +genclient
+// +genclient:onlyVerbs=get,list,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=workloads
+
+// Workload is the unit of admission in Kueue, it is created by Kueue for every queued job.
+type Workload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkloadSpec   `json:"spec,omitempty"`
+	Status WorkloadStatus `json:"status,omitempty"`
+}
+
+// WorkloadSpec defines the desired state of Workload
+type WorkloadSpec struct {
+	// PodSets is a list of sets of homogeneous pods
+	PodSets []PodSet `json:"podSets,omitempty"`
+	// QueueName is the name of the LocalQueue the Workload is associated with
+	QueueName string `json:"queueName,omitempty"`
+	// PriorityClassName is the name of the PriorityClass the Workload is associated with
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Priority determines the order of access to the resources managed by the ClusterQueue
+	Priority *int32 `json:"priority,omitempty"`
+	// Active determines if a workload can be admitted into a queue
+	Active *bool `json:"active,omitempty"`
+}
+
+// PodSet is a set of homogeneous pods of a Workload.
+// The pod template is not decoded since arena does not need it.
+type PodSet struct {
+	// Name is the PodSet name
+	Name string `json:"name"`
+	// Count is the number of pods of the PodSet
+	Count int32 `json:"count"`
+	// MinCount is the minimum number of pods of the PodSet for partial admission
+	MinCount *int32 `json:"minCount,omitempty"`
+}
+
+// WorkloadStatus defines the observed state of Workload
+type WorkloadStatus struct {
+	// Admission holds the parameters of the admission of the Workload by a ClusterQueue
+	Admission *Admission `json:"admission,omitempty"`
+	// RequeueState holds the re-queue state when the Workload was evicted
+	RequeueState *RequeueState `json:"requeueState,omitempty"`
+	// Conditions hold the latest available observations of the Workload current state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Admission holds the parameters of the admission of a Workload
+type Admission struct {
+	// ClusterQueue is the name of the ClusterQueue that admitted the Workload
+	ClusterQueue ClusterQueueReference `json:"clusterQueue"`
+	// PodSetAssignments hold the admission results for each of the PodSets of the Workload
+	PodSetAssignments []PodSetAssignment `json:"podSetAssignments,omitempty"`
+}
+
+// PodSetAssignment holds the admission result of a PodSet
+type PodSetAssignment struct {
+	// Name is the name of the PodSet
+	Name string `json:"name"`
+	// Flavors are the flavors assigned to the PodSet for each resource
+	Flavors map[corev1.ResourceName]ResourceFlavorReference `json:"flavors,omitempty"`
+	// ResourceUsage keeps track of the total resources all the pods in the PodSet need to run
+	ResourceUsage corev1.ResourceList `json:"resourceUsage,omitempty"`
+	// Count is the number of pods taken into account at admission time
+	Count *int32 `json:"count,omitempty"`
+}
+
+// RequeueState holds the re-queue state of an evicted Workload
+type RequeueState struct {
+	// Count records the number of times the Workload has been re-queued
+	Count *int32 `json:"count,omitempty"`
+	// RequeueAt records the time when the Workload will be re-queued
+	RequeueAt *metav1.Time `json:"requeueAt,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkloadList contains a list of Workload
+type WorkloadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Workload `json:"items"`
+}
+
+// +genclient
+// +genclient:onlyVerbs=get,list,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=localqueues
+
+// LocalQueue is the namespaced queue which users submit their jobs to
+type LocalQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LocalQueueSpec   `json:"spec,omitempty"`
+	Status LocalQueueStatus `json:"status,omitempty"`
+}
+
+// LocalQueueSpec defines the desired state of LocalQueue
+type LocalQueueSpec struct {
+	// ClusterQueue is a reference to a ClusterQueue backing this LocalQueue
+	ClusterQueue ClusterQueueReference `json:"clusterQueue,omitempty"`
+	// StopPolicy determines if the LocalQueue should be stopped, e.g. Hold or HoldAndDrain
+	StopPolicy string `json:"stopPolicy,omitempty"`
+}
+
+// LocalQueueStatus defines the observed state of LocalQueue
+type LocalQueueStatus struct {
+	// PendingWorkloads is the number of Workloads in the LocalQueue not yet admitted
+	PendingWorkloads int32 `json:"pendingWorkloads"`
+	// ReservingWorkloads is the number of Workloads in the LocalQueue reserving quota
+	ReservingWorkloads int32 `json:"reservingWorkloads"`
+	// AdmittedWorkloads is the number of admitted Workloads in the LocalQueue
+	AdmittedWorkloads int32 `json:"admittedWorkloads"`
+	// Conditions hold the latest available observations of the LocalQueue current state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LocalQueueList contains a list of LocalQueue
+type LocalQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocalQueue `json:"items"`
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/kubeflow/arena/pkg/operators/kueue-operator/apis/kueue/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWorkloads implements WorkloadInterface
+type FakeWorkloads struct {
+	Fake *FakeKueueV1beta1
+	ns   string
+}
+
+var workloadsResource = v1beta1.SchemeGroupVersion.WithResource("workloads")
+
+var workloadsKind = v1beta1.SchemeGroupVersion.WithKind("Workload")
+
+// Get takes name of the workload, and returns the corresponding workload object, and an error if there is any.
+func (c *FakeWorkloads) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Workload, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(workloadsResource, c.ns, name), &v1beta1.Workload{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Workload), err
+}
+
+// List takes label and field selectors, and returns the list of Workloads that match those selectors.
+func (c *FakeWorkloads) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.WorkloadList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(workloadsResource, workloadsKind, c.ns, opts), &v1beta1.WorkloadList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.WorkloadList{ListMeta: obj.(*v1beta1.WorkloadList).ListMeta}
+	for _, item := range obj.(*v1beta1.WorkloadList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested workloads.
+func (c *FakeWorkloads) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(workloadsResource, c.ns, opts))
+
+}
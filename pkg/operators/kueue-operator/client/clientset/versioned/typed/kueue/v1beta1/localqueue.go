@@ -0,0 +1,101 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/kubeflow/arena/pkg/operators/kueue-operator/apis/kueue/v1beta1"
+	scheme "github.com/kubeflow/arena/pkg/operators/kueue-operator/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// LocalQueuesGetter has a method to return a LocalQueueInterface.
+// A group's client should implement this interface.
+type LocalQueuesGetter interface {
+	LocalQueues(namespace string) LocalQueueInterface
+}
+
+// LocalQueueInterface has methods to work with LocalQueue resources.
+type LocalQueueInterface interface {
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.LocalQueue, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.LocalQueueList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	LocalQueueExpansion
+}
+
+// localQueues implements LocalQueueInterface
+type localQueues struct {
+	client rest.Interface
+	ns     string
+}
+
+// newLocalQueues returns a LocalQueues
+func newLocalQueues(c *KueueV1beta1Client, namespace string) *localQueues {
+	return &localQueues{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the localQueue, and returns the corresponding localQueue object, and an error if there is any.
+func (c *localQueues) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.LocalQueue, err error) {
+	result = &v1beta1.LocalQueue{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("localqueues").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of LocalQueues that match those selectors.
+func (c *localQueues) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.LocalQueueList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.LocalQueueList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("localqueues").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested localQueues.
+func (c *localQueues) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("localqueues").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
@@ -0,0 +1,316 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podlogs
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+const (
+	// refreshInterval is the interval to pick up the new instances in follow mode
+	refreshInterval = 5 * time.Second
+	// reconnectInterval is the interval to reconnect to an instance after its log stream is closed in follow mode
+	reconnectInterval = 2 * time.Second
+	// mergeWindow is the window in which the lines are ordered by their timestamps in follow mode
+	mergeWindow = time.Second
+)
+
+// prefixColors are the ANSI colors of the instance prefixes
+var prefixColors = []int{32, 33, 34, 35, 36, 91, 92, 93, 94, 95, 96}
+
+// LogSource is an instance whose logs are printed by the MultiPodLogger
+type LogSource struct {
+	PodName string
+	PodUID  string
+	// Prefix is printed in brackets before every line of the instance
+	Prefix string
+}
+
+// MultiPodLogger streams the logs of many instances concurrently, every line is printed with the prefix of its instance
+type MultiPodLogger struct {
+	clientset kubernetes.Interface
+	*types.LogArgs
+	// sources returns the current instances, it is called again in follow mode to pick up the recreated instances
+	sources func() ([]LogSource, error)
+	grep    *regexp.Regexp
+	color   bool
+
+	lock sync.Mutex
+	// active is the pods whose logs are streamed
+	active map[string]bool
+	// streamed is the uid of the pods whose logs have been streamed
+	streamed map[string]string
+	colors   map[string]int
+}
+
+// logLine is a line of the logs with its timestamp
+type logLine struct {
+	source    LogSource
+	timestamp time.Time
+	rawTime   string
+	text      string
+}
+
+func NewMultiPodLogger(args *types.LogArgs, sources func() ([]LogSource, error)) (*MultiPodLogger, error) {
+	m := &MultiPodLogger{
+		clientset: kubernetes.NewForConfigOrDie(config.GetArenaConfiger().GetRestConfig()),
+		LogArgs:   args,
+		sources:   sources,
+		active:    map[string]bool{},
+		streamed:  map[string]string{},
+		colors:    map[string]int{},
+	}
+	if args.Grep != "" {
+		grep, err := regexp.Compile(args.Grep)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --grep %v: %v", args.Grep, err)
+		}
+		m.grep = grep
+	}
+	if f, ok := args.WriterCloser.(*os.File); ok {
+		info, err := f.Stat()
+		m.color = err == nil && info.Mode()&os.ModeCharDevice != 0
+	}
+	return m, nil
+}
+
+// AcceptLogs streams the logs of all the instances until they are finished, in follow mode it keeps
+// streaming the restarted and recreated instances until none of the instances is running
+func (m *MultiPodLogger) AcceptLogs() error {
+	sources, err := m.sources()
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		return ErrPodNotFound
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lines := make(chan logLine, 1024)
+	printed := make(chan struct{})
+	go func() {
+		m.print(lines)
+		close(printed)
+	}()
+	var wg sync.WaitGroup
+	m.start(ctx, &wg, sources, lines)
+	if m.Follow {
+		ticker := time.NewTicker(refreshInterval)
+		for range ticker.C {
+			sources, err := m.sources()
+			if err != nil {
+				log.Debugf("failed to refresh the instances: %v", err)
+			} else {
+				m.start(ctx, &wg, sources, lines)
+			}
+			m.lock.Lock()
+			active := len(m.active)
+			m.lock.Unlock()
+			if active == 0 {
+				break
+			}
+		}
+		ticker.Stop()
+	}
+	wg.Wait()
+	close(lines)
+	<-printed
+	return nil
+}
+
+// start streams the logs of the sources which are not streamed yet
+func (m *MultiPodLogger) start(ctx context.Context, wg *sync.WaitGroup, sources []LogSource, lines chan<- logLine) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, source := range sources {
+		if m.active[source.PodName] || m.streamed[source.PodName] == source.PodUID {
+			continue
+		}
+		if _, ok := m.colors[source.PodName]; !ok {
+			m.colors[source.PodName] = prefixColors[len(m.colors)%len(prefixColors)]
+		}
+		m.active[source.PodName] = true
+		m.streamed[source.PodName] = source.PodUID
+		wg.Add(1)
+		go func(source LogSource) {
+			defer wg.Done()
+			m.stream(ctx, source, lines)
+			m.lock.Lock()
+			delete(m.active, source.PodName)
+			m.lock.Unlock()
+		}(source)
+	}
+}
+
+// stream sends the lines of the instance, in follow mode it reconnects after the container is restarted
+// and returns when the pod is deleted or finished
+func (m *MultiPodLogger) stream(ctx context.Context, source LogSource, lines chan<- logLine) {
+	var last time.Time
+	for {
+		options := &corev1.PodLogOptions{
+			Container:    m.ContainerName,
+			Follow:       m.Follow,
+			Timestamps:   true,
+			SinceSeconds: m.SinceSeconds,
+			SinceTime:    m.SinceTime,
+			TailLines:    m.Tail,
+		}
+		if !last.IsZero() {
+			sinceTime := metav1.NewTime(last)
+			options.SinceSeconds, options.SinceTime, options.TailLines = nil, &sinceTime, nil
+		}
+		reader, err := m.clientset.CoreV1().Pods(m.Namespace).GetLogs(source.PodName, options).Stream(ctx)
+		if err == nil {
+			last = m.read(reader, source, last, lines)
+			reader.Close()
+		} else if !m.Follow {
+			log.Warnf("failed to get the logs of %v: %v", source.PodName, err)
+		} else {
+			log.Debugf("failed to get the logs of %v: %v", source.PodName, err)
+		}
+		if !m.Follow || ctx.Err() != nil {
+			return
+		}
+		pod, err := m.clientset.CoreV1().Pods(m.Namespace).Get(ctx, source.PodName, metav1.GetOptions{})
+		switch {
+		case k8serrors.IsNotFound(err):
+			return
+		case err != nil:
+			log.Debugf("failed to get the pod %v: %v", source.PodName, err)
+		case string(pod.UID) != source.PodUID:
+			// the pod is recreated, it is streamed as a new instance on the next refresh
+			return
+		case pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed:
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+// read sends the lines of the reader and returns the timestamp of the last line, the lines not after
+// resumeAfter are skipped since they are streamed again on reconnection
+func (m *MultiPodLogger) read(reader io.Reader, source LogSource, resumeAfter time.Time, lines chan<- logLine) time.Time {
+	last := resumeAfter
+	buffer := bufio.NewReader(reader)
+	for {
+		content, err := buffer.ReadString('\n')
+		if content != "" {
+			line := parseLogLine(source, strings.TrimSuffix(content, "\n"))
+			switch {
+			case !resumeAfter.IsZero() && !line.timestamp.After(resumeAfter):
+			case m.grep != nil && !m.grep.MatchString(line.text):
+			default:
+				lines <- line
+			}
+			if line.timestamp.After(last) {
+				last = line.timestamp
+			}
+		}
+		if err != nil {
+			if err != io.EOF {
+				log.Debugf("failed to read the logs of %v: %v", source.PodName, err)
+			}
+			return last
+		}
+	}
+}
+
+// print prints the lines, they are ordered by their timestamps if Merge is set
+func (m *MultiPodLogger) print(lines <-chan logLine) {
+	if !m.Merge {
+		for line := range lines {
+			m.printLine(line)
+		}
+		return
+	}
+	buffered := []logLine{}
+	flush := func() {
+		sort.SliceStable(buffered, func(i, j int) bool {
+			return buffered[i].timestamp.Before(buffered[j].timestamp)
+		})
+		for _, line := range buffered {
+			m.printLine(line)
+		}
+		buffered = buffered[:0]
+	}
+	var tick <-chan time.Time
+	if m.Follow {
+		ticker := time.NewTicker(mergeWindow)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				flush()
+				return
+			}
+			buffered = append(buffered, line)
+		case <-tick:
+			flush()
+		}
+	}
+}
+
+func (m *MultiPodLogger) printLine(line logLine) {
+	prefix := fmt.Sprintf("[%v]", line.source.Prefix)
+	if m.color {
+		prefix = fmt.Sprintf("\033[%dm%v\033[0m", m.colors[line.source.PodName], prefix)
+	}
+	text := line.text
+	if m.Timestamps && line.rawTime != "" {
+		text = line.rawTime + " " + text
+	}
+	fmt.Fprintf(m.WriterCloser, "%v %v\n", prefix, text)
+}
+
+// parseLogLine splits the timestamp added by the log option Timestamps from the line
+func parseLogLine(source LogSource, content string) logLine {
+	line := logLine{source: source, text: content}
+	rawTime, text, found := strings.Cut(content, " ")
+	if !found {
+		rawTime, text = content, ""
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, rawTime)
+	if err != nil {
+		return line
+	}
+	line.timestamp, line.rawTime, line.text = timestamp, rawTime, text
+	return line
+}
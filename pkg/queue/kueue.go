@@ -0,0 +1,228 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+	kueuev1beta1 "github.com/kubeflow/arena/pkg/operators/kueue-operator/apis/kueue/v1beta1"
+	"github.com/kubeflow/arena/pkg/operators/kueue-operator/client/clientset/versioned"
+)
+
+var (
+	// ErrKueueNotInstalled is returned when the Kueue CRDs are not found in the cluster
+	ErrKueueNotInstalled = fmt.Errorf("kueue is not installed in the cluster")
+
+	kueueClient    versioned.Interface
+	errKueueClient error
+	kueueOnce      sync.Once
+)
+
+// GetKueueClient returns the Kueue clientset, it returns ErrKueueNotInstalled
+// if the Workload CRD does not exist
+func GetKueueClient() (versioned.Interface, error) {
+	kueueOnce.Do(func() {
+		configer := config.GetArenaConfiger()
+		_, err := configer.GetAPIExtensionClientSet().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), k8saccesser.KueueWorkloadCRDName, metav1.GetOptions{})
+		if err != nil {
+			log.Debugf("kueue is disabled, reason: %v", err)
+			errKueueClient = ErrKueueNotInstalled
+			return
+		}
+		kueueClient, errKueueClient = versioned.NewForConfig(configer.GetRestConfig())
+	})
+	return kueueClient, errKueueClient
+}
+
+// ListWorkloads lists the Kueue Workloads in the namespace, use metav1.NamespaceAll for all namespaces
+func ListWorkloads(client versioned.Interface, namespace string) ([]kueuev1beta1.Workload, error) {
+	workloadList, err := client.KueueV1beta1().Workloads(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return workloadList.Items, nil
+}
+
+// ListQueuedWorkloads lists the Workloads and maps the LocalQueues to their ClusterQueues, the Workloads of
+// all namespaces are listed since the Workloads of all LocalQueues pointing to a ClusterQueue are queued together.
+// Only the Workloads and LocalQueues of the namespace are listed if the user can not list them in all namespaces
+func ListQueuedWorkloads(client versioned.Interface, namespace string) ([]kueuev1beta1.Workload, map[string]string, error) {
+	workloads, err := ListWorkloads(client, metav1.NamespaceAll)
+	listNamespace := metav1.NamespaceAll
+	if k8serrors.IsForbidden(err) && namespace != metav1.NamespaceAll {
+		log.Debugf("failed to list kueue workloads in all namespaces, only rank the workloads in namespace %v: %v", namespace, err)
+		listNamespace = namespace
+		workloads, err = ListWorkloads(client, namespace)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	clusterQueues := map[string]string{}
+	queueList, err := client.KueueV1beta1().LocalQueues(listNamespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		// the Workloads are ranked in their LocalQueue without the mapping
+		log.Debugf("failed to list kueue local queues: %v", err)
+		return workloads, clusterQueues, nil
+	}
+	for _, q := range queueList.Items {
+		clusterQueues[localQueueKey(q.Namespace, q.Name)] = string(q.Spec.ClusterQueue)
+	}
+	return workloads, clusterQueues, nil
+}
+
+// WorkloadOfJob returns the Workload created by Kueue for the job with the given uid
+func WorkloadOfJob(uid k8stypes.UID, workloads []kueuev1beta1.Workload) *kueuev1beta1.Workload {
+	for i := range workloads {
+		if workloads[i].Labels[kueuev1beta1.JobUIDLabel] == string(uid) {
+			return &workloads[i]
+		}
+		for _, owner := range workloads[i].OwnerReferences {
+			if owner.UID == uid {
+				return &workloads[i]
+			}
+		}
+	}
+	return nil
+}
+
+// BuildWorkloadInfo builds the admission state of the Workload, the other Workloads queued
+// in the same ClusterQueue are used to compute the queue position, clusterQueues maps the
+// LocalQueues to their ClusterQueues like ListQueuedWorkloads
+func BuildWorkloadInfo(workload *kueuev1beta1.Workload, workloads []kueuev1beta1.Workload, clusterQueues map[string]string) *types.KueueWorkloadInfo {
+	if workload == nil {
+		return nil
+	}
+	info := &types.KueueWorkloadInfo{
+		Name:          workload.Name,
+		LocalQueue:    workload.Spec.QueueName,
+		QuotaReserved: isConditionTrue(workload, kueuev1beta1.WorkloadQuotaReserved),
+		Admitted:      isConditionTrue(workload, kueuev1beta1.WorkloadAdmitted),
+	}
+	if workload.Status.Admission != nil {
+		info.ClusterQueue = string(workload.Status.Admission.ClusterQueue)
+	}
+	if !IsPendingWorkload(workload) {
+		return info
+	}
+	// an evicted workload explains itself better than the QuotaReserved condition
+	for _, conditionType := range []string{kueuev1beta1.WorkloadEvicted, kueuev1beta1.WorkloadQuotaReserved, kueuev1beta1.WorkloadAdmitted} {
+		cond := getCondition(workload, conditionType)
+		if cond == nil {
+			continue
+		}
+		if conditionType == kueuev1beta1.WorkloadEvicted && cond.Status != metav1.ConditionTrue {
+			continue
+		}
+		if conditionType != kueuev1beta1.WorkloadEvicted && cond.Status == metav1.ConditionTrue {
+			continue
+		}
+		info.PendingReason = cond.Reason
+		info.PendingMessage = cond.Message
+		break
+	}
+	info.QueuePosition = queuePosition(workload, workloads, clusterQueues)
+	return info
+}
+
+// IsPendingWorkload returns true if the Workload is neither admitted nor finished
+func IsPendingWorkload(workload *kueuev1beta1.Workload) bool {
+	return !isConditionTrue(workload, kueuev1beta1.WorkloadAdmitted) && !isConditionTrue(workload, kueuev1beta1.WorkloadFinished)
+}
+
+// queuePosition returns the position of the pending Workload among the pending Workloads of all
+// LocalQueues pointing to its ClusterQueue, ordered by priority and then by the eviction or creation
+// time like the Kueue queue manager. A LocalQueue without known ClusterQueue is ranked on its own
+func queuePosition(workload *kueuev1beta1.Workload, workloads []kueuev1beta1.Workload, clusterQueues map[string]string) int {
+	clusterQueue := clusterQueueOf(workload, clusterQueues)
+	pending := []*kueuev1beta1.Workload{}
+	for i := range workloads {
+		w := &workloads[i]
+		if clusterQueueOf(w, clusterQueues) != clusterQueue {
+			continue
+		}
+		if IsPendingWorkload(w) && !isConditionTrue(w, kueuev1beta1.WorkloadQuotaReserved) {
+			pending = append(pending, w)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		pi, pj := priorityOf(pending[i]), priorityOf(pending[j])
+		if pi != pj {
+			return pi > pj
+		}
+		ti, tj := queueOrderTime(pending[i]), queueOrderTime(pending[j])
+		return ti.Before(&tj)
+	})
+	for i, w := range pending {
+		if w.UID == workload.UID && w.Name == workload.Name {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// clusterQueueOf returns the ClusterQueue of the LocalQueue of the Workload, or the
+// LocalQueue itself if its ClusterQueue is unknown
+func clusterQueueOf(workload *kueuev1beta1.Workload, clusterQueues map[string]string) string {
+	key := localQueueKey(workload.Namespace, workload.Spec.QueueName)
+	if clusterQueue, ok := clusterQueues[key]; ok {
+		return clusterQueue
+	}
+	return key
+}
+
+func localQueueKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// queueOrderTime returns the time the Workload is queued at, an evicted Workload is requeued
+// at its eviction time
+func queueOrderTime(workload *kueuev1beta1.Workload) metav1.Time {
+	if cond := getCondition(workload, kueuev1beta1.WorkloadEvicted); cond != nil && cond.Status == metav1.ConditionTrue {
+		return cond.LastTransitionTime
+	}
+	return workload.CreationTimestamp
+}
+
+func priorityOf(workload *kueuev1beta1.Workload) int32 {
+	if workload.Spec.Priority == nil {
+		return 0
+	}
+	return *workload.Spec.Priority
+}
+
+func getCondition(workload *kueuev1beta1.Workload, conditionType string) *metav1.Condition {
+	for i := range workload.Status.Conditions {
+		if workload.Status.Conditions[i].Type == conditionType {
+			return &workload.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func isConditionTrue(workload *kueuev1beta1.Workload, conditionType string) bool {
+	cond := getCondition(workload, conditionType)
+	return cond != nil && cond.Status == metav1.ConditionTrue
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	yaml "gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// ListLocalQueues lists the Kueue LocalQueues with their pending and admitted workload counts
+func ListLocalQueues(namespace string, allNamespaces bool) ([]*types.KueueLocalQueueInfo, error) {
+	client, err := GetKueueClient()
+	if err != nil {
+		return nil, err
+	}
+	if allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+	queueList, err := client.KueueV1beta1().LocalQueues(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list local queues: %v", err)
+	}
+	queues := []*types.KueueLocalQueueInfo{}
+	for _, q := range queueList.Items {
+		queues = append(queues, &types.KueueLocalQueueInfo{
+			Name:               q.Name,
+			Namespace:          q.Namespace,
+			ClusterQueue:       string(q.Spec.ClusterQueue),
+			PendingWorkloads:   q.Status.PendingWorkloads,
+			ReservingWorkloads: q.Status.ReservingWorkloads,
+			AdmittedWorkloads:  q.Status.AdmittedWorkloads,
+			StopPolicy:         q.Spec.StopPolicy,
+		})
+	}
+	return queues, nil
+}
+
+// DisplayLocalQueues prints the LocalQueues in the given format
+func DisplayLocalQueues(queues []*types.KueueLocalQueueInfo, format string, allNamespaces bool) error {
+	switch format {
+	case "json":
+		data, _ := json.MarshalIndent(queues, "", "    ")
+		fmt.Printf("%v", string(data))
+		return nil
+	case "yaml":
+		data, _ := yaml.Marshal(queues)
+		fmt.Printf("%v", string(data))
+		return nil
+	case "", "wide":
+	default:
+		return fmt.Errorf("unknown format,only support: [yaml,json,wide]")
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := "NAME\tCLUSTER_QUEUE\tPENDING\tRESERVING\tADMITTED"
+	if allNamespaces {
+		header = "NAMESPACE\t" + header
+	}
+	if format == "wide" {
+		header += "\tSTOP_POLICY"
+	}
+	fmt.Fprintln(w, header)
+	for _, q := range queues {
+		line := fmt.Sprintf("%v\t%v\t%v\t%v\t%v", q.Name, q.ClusterQueue, q.PendingWorkloads, q.ReservingWorkloads, q.AdmittedWorkloads)
+		if allNamespaces {
+			line = q.Namespace + "\t" + line
+		}
+		if format == "wide" {
+			stopPolicy := q.StopPolicy
+			if stopPolicy == "" {
+				stopPolicy = "None"
+			}
+			line += "\t" + stopPolicy
+		}
+		fmt.Fprintln(w, line)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranktable
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	volcanov1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+)
+
+const (
+	// taskIndexAnnotation is set by volcano on the pods with their index in the task
+	taskIndexAnnotation = "volcano.sh/task-index"
+	// resyncPeriod retries the rank tables whose update failed
+	resyncPeriod = 30 * time.Second
+)
+
+// Publisher watches the rank table ConfigMaps labeled by arena and the pods of their volcano jobs, and
+// writes the rank table built from the device annotations of the pods into the ConfigMaps
+type Publisher struct {
+	clientset kubernetes.Interface
+	namespace string
+
+	configMaps corelisters.ConfigMapLister
+	pods       corelisters.PodLister
+}
+
+// NewPublisher creates a publisher of the rank tables in the namespace, all the namespaces are watched if it is empty
+func NewPublisher(clientset kubernetes.Interface, namespace string) *Publisher {
+	return &Publisher{clientset: clientset, namespace: namespace}
+}
+
+// Run publishes the rank tables until the context is done
+func (p *Publisher) Run(ctx context.Context) error {
+	configMapFactory := informers.NewSharedInformerFactoryWithOptions(p.clientset, resyncPeriod,
+		informers.WithNamespace(p.namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = Label
+		}))
+	podFactory := informers.NewSharedInformerFactoryWithOptions(p.clientset, 0,
+		informers.WithNamespace(p.namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = volcanov1alpha1.JobNameKey
+		}))
+	configMapInformer := configMapFactory.Core().V1().ConfigMaps()
+	podInformer := podFactory.Core().V1().Pods()
+	p.configMaps = configMapInformer.Lister()
+	p.pods = podInformer.Lister()
+
+	onConfigMap := func(obj interface{}) {
+		if configMap, ok := obj.(*corev1.ConfigMap); ok {
+			p.sync(ctx, configMap.Namespace, strings.TrimPrefix(configMap.Name, ConfigMapPrefix))
+		}
+	}
+	onPod := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		if pod, ok := obj.(*corev1.Pod); ok {
+			p.sync(ctx, pod.Namespace, pod.Labels[volcanov1alpha1.JobNameKey])
+		}
+	}
+	if _, err := configMapInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    onConfigMap,
+		UpdateFunc: func(oldObj, newObj interface{}) { onConfigMap(newObj) },
+	}); err != nil {
+		return err
+	}
+	if _, err := podInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    onPod,
+		UpdateFunc: func(oldObj, newObj interface{}) { onPod(newObj) },
+		DeleteFunc: onPod,
+	}); err != nil {
+		return err
+	}
+	// the pods are synced first so the rank tables are not built from a partial pod list
+	podFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), podInformer.Informer().HasSynced) {
+		return fmt.Errorf("failed to sync the pods")
+	}
+	configMapFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), configMapInformer.Informer().HasSynced) {
+		return fmt.Errorf("failed to sync the rank table configmaps")
+	}
+	<-ctx.Done()
+	configMapFactory.Shutdown()
+	podFactory.Shutdown()
+	return nil
+}
+
+// sync writes the rank table of the job into its ConfigMap if it is changed
+func (p *Publisher) sync(ctx context.Context, namespace, job string) {
+	if job == "" {
+		return
+	}
+	configMap, err := p.configMaps.ConfigMaps(namespace).Get(ConfigMapPrefix + job)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.Warnf("failed to get the rank table of job %v/%v: %v", namespace, job, err)
+		}
+		return
+	}
+	tasks, err := ParseTasks(configMap.Annotations[TasksAnnotation])
+	if err != nil {
+		log.Warnf("failed to parse the tasks of rank table %v/%v: %v", namespace, configMap.Name, err)
+		return
+	}
+	pods, err := p.pods.Pods(namespace).List(labels.SelectorFromSet(labels.Set{volcanov1alpha1.JobNameKey: job}))
+	if err != nil {
+		log.Warnf("failed to list the pods of job %v/%v: %v", namespace, job, err)
+		return
+	}
+	rankTable := Build(membersOfTasks(tasks, pods), expectedServersOfTasks(tasks))
+	data, err := rankTable.Data()
+	if err != nil {
+		log.Warnf("failed to build the rank table of job %v/%v: %v", namespace, job, err)
+		return
+	}
+	podUIDs := rankTable.Pods()
+	if configMap.Data[FileName] == data && configMap.Data[PodsFileName] == podUIDs {
+		return
+	}
+	configMap = configMap.DeepCopy()
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[FileName] = data
+	configMap.Data[PodsFileName] = podUIDs
+	if _, err := p.clientset.CoreV1().ConfigMaps(namespace).Update(ctx, configMap, metav1.UpdateOptions{}); err != nil {
+		log.Warnf("failed to update the rank table of job %v/%v: %v", namespace, job, err)
+		return
+	}
+	log.Infof("updated the rank table of job %v/%v: %v", namespace, job, data)
+}
+
+// membersOfTasks returns the pods of the job belonging to the tasks with their task order and index,
+// the pods being deleted are skipped so the pods of a restarted job do not share the ranks of the old ones
+func membersOfTasks(tasks []Task, pods []*corev1.Pod) []Member {
+	taskOrder := map[string]int{}
+	for i, task := range tasks {
+		taskOrder[task.Name] = i
+	}
+	members := []Member{}
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		task, ok := taskOrder[pod.Annotations[volcanov1alpha1.TaskSpecKey]]
+		if !ok {
+			continue
+		}
+		index, err := strconv.Atoi(pod.Annotations[taskIndexAnnotation])
+		if err != nil {
+			continue
+		}
+		members = append(members, Member{Pod: pod, Task: task, Index: index})
+	}
+	return members
+}
+
+func expectedServersOfTasks(tasks []Task) int {
+	expectedServers := 0
+	for _, task := range tasks {
+		expectedServers += task.Replicas
+	}
+	return expectedServers
+}
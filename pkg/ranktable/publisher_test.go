@@ -0,0 +1,94 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranktable
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+
+	volcanov1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+)
+
+func TestPublisher(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ConfigMapPrefix + "test",
+			Namespace:   "default",
+			Labels:      map[string]string{Label: "true"},
+			Annotations: map[string]string{TasksAnnotation: "worker:2"},
+		},
+		Data: map[string]string{FileName: `{"status":"initializing"}`},
+	}
+	unlabeled := configMap.DeepCopy()
+	unlabeled.Name = ConfigMapPrefix + "other"
+	unlabeled.Labels = nil
+	clientset := fake.NewSimpleClientset(configMap, unlabeled,
+		newAscendPod("test-worker-0", "worker", "0", "10.0.0.1", "172.16.0.1"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		if err := NewPublisher(clientset, "default").Run(ctx); err != nil {
+			t.Errorf("failed to run the publisher: %v", err)
+		}
+	}()
+
+	configMapData := func(name, key string) string {
+		configMap, err := clientset.CoreV1().ConfigMaps("default").Get(ctx, ConfigMapPrefix+name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("failed to get the rank table of %v: %v", name, err)
+		}
+		return configMap.Data[key]
+	}
+	rankTableOf := func(name string) string {
+		return configMapData(name, FileName)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if data := rankTableOf("test"); data != `{"status":"initializing"}` {
+		t.Errorf("expected the rank table to be initializing until all the servers are known, got %v", data)
+	}
+
+	other := newAscendPod("other-worker-0", "worker", "0", "10.0.0.3", "")
+	other.Labels[volcanov1alpha1.JobNameKey] = "other"
+	if _, err := clientset.CoreV1().Pods("default").Create(ctx, other, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create the pod: %v", err)
+	}
+	if _, err := clientset.CoreV1().Pods("default").Create(ctx,
+		newAscendPod("test-worker-1", "worker", "1", "10.0.0.2", "172.16.0.2"), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create the pod: %v", err)
+	}
+	err := wait.PollUntilContextTimeout(ctx, 50*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		return strings.Contains(rankTableOf("test"), `"status":"completed"`), nil
+	})
+	if err != nil {
+		t.Fatalf("expected the rank table to be completed, got %v", rankTableOf("test"))
+	}
+	if data := rankTableOf("test"); !strings.Contains(data, `"server_count":"2"`) || !strings.Contains(data, `"rank_id":"3"`) {
+		t.Errorf("expected the rank table of the 2 workers, got %v", data)
+	}
+	if podUIDs := configMapData("test", PodsFileName); podUIDs != "test-worker-0-uid\ntest-worker-1-uid" {
+		t.Errorf("expected the pods of the 2 workers listed with the rank table, got %q", podUIDs)
+	}
+	if data := rankTableOf("other"); data != `{"status":"initializing"}` {
+		t.Errorf("expected the ConfigMap without the label to be left alone, got %v", data)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranktable
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DeviceConfigAnnotation is set by the Ascend device plugin on the scheduled pods with their devices
+	DeviceConfigAnnotation = "ascend.kubectl.kubernetes.io/ascend-910-configuration"
+	// ConfigMapPrefix is the prefix of the rank table ConfigMap of a job, the ConfigMap is rings-config-<job>
+	ConfigMapPrefix = "rings-config-"
+	// Label marks the rank table ConfigMaps filled by the publisher
+	Label = "arena.kubeflow.org/rank-table"
+	// TasksAnnotation lists the tasks requesting Ascend NPUs in rank order with their replicas, e.g. master:1,worker:4
+	TasksAnnotation = "arena.kubeflow.org/rank-table-tasks"
+	// FileName is the key of the rank table in the ConfigMap
+	FileName = "hccl.json"
+	// PodsFileName is the key of the UIDs of the pods of the completed rank table in the ConfigMap, a pod
+	// waits until its own UID is listed so it never reads the rank table of the previous attempt of the job
+	PodsFileName = "hccl-pods"
+
+	StatusCompleted    = "completed"
+	StatusInitializing = "initializing"
+
+	version = "1.0"
+)
+
+// RankTable is the hccl.json read by HCCL, the server list is only set once it is completed
+type RankTable struct {
+	Status      string   `json:"status"`
+	Version     string   `json:"version,omitempty"`
+	ServerCount string   `json:"server_count,omitempty"`
+	ServerList  []Server `json:"server_list,omitempty"`
+}
+
+// Server is a pod of the rank table with its devices
+type Server struct {
+	// Pod is the name of the pod, it is not part of hccl.json
+	Pod string `json:"-"`
+	// PodUID is the UID of the pod, it is not part of hccl.json
+	PodUID      string   `json:"-"`
+	ServerID    string   `json:"server_id"`
+	ContainerIP string   `json:"container_ip,omitempty"`
+	HostNicIP   string   `json:"host_nic_ip"`
+	Devices     []Device `json:"device"`
+}
+
+// Device is an Ascend device of a server with its global rank
+type Device struct {
+	DeviceID string `json:"device_id"`
+	DeviceIP string `json:"device_ip"`
+	RankID   string `json:"rank_id"`
+}
+
+// Member is a pod of the job with its task order and task index
+type Member struct {
+	Pod   *corev1.Pod
+	Task  int
+	Index int
+}
+
+// podDeviceConfig is the value of the device annotation of an Ascend pod
+type podDeviceConfig struct {
+	PodName  string `json:"pod_name"`
+	ServerID string `json:"server_id"`
+	Devices  []struct {
+		DeviceID string `json:"device_id"`
+		DeviceIP string `json:"device_ip"`
+	} `json:"devices"`
+}
+
+// Build builds the rank table from the device annotations of the members, they are ranked by task order
+// then task index. The members without the annotation are skipped, the rank table is completed once the
+// devices of the expected servers are known
+func Build(members []Member, expectedServers int) *RankTable {
+	type rankedMember struct {
+		Member
+		config podDeviceConfig
+	}
+	rankedMembers := []rankedMember{}
+	for _, member := range members {
+		value, found := member.Pod.Annotations[DeviceConfigAnnotation]
+		if !found {
+			continue
+		}
+		var config podDeviceConfig
+		if err := json.Unmarshal([]byte(value), &config); err != nil {
+			log.Debugf("failed to parse the device annotation of pod %s: %v", member.Pod.Name, err)
+			continue
+		}
+		rankedMembers = append(rankedMembers, rankedMember{Member: member, config: config})
+	}
+	sort.SliceStable(rankedMembers, func(i, j int) bool {
+		if rankedMembers[i].Task != rankedMembers[j].Task {
+			return rankedMembers[i].Task < rankedMembers[j].Task
+		}
+		return rankedMembers[i].Index < rankedMembers[j].Index
+	})
+
+	rankTable := &RankTable{Status: StatusInitializing, Version: version}
+	rank := 0
+	for _, member := range rankedMembers {
+		server := Server{
+			Pod:         member.Pod.Name,
+			PodUID:      string(member.Pod.UID),
+			ServerID:    member.config.ServerID,
+			ContainerIP: member.Pod.Status.PodIP,
+			HostNicIP:   "reserve",
+		}
+		for _, device := range member.config.Devices {
+			server.Devices = append(server.Devices, Device{
+				DeviceID: device.DeviceID,
+				DeviceIP: device.DeviceIP,
+				RankID:   strconv.Itoa(rank),
+			})
+			rank++
+		}
+		rankTable.ServerList = append(rankTable.ServerList, server)
+	}
+	rankTable.ServerCount = strconv.Itoa(len(rankTable.ServerList))
+	if expectedServers > 0 && len(rankTable.ServerList) >= expectedServers {
+		rankTable.Status = StatusCompleted
+	}
+	return rankTable
+}
+
+// Data returns the hccl.json of the rank table, only the status is written until it is completed
+// so the pods never read a partial server list
+func (r *RankTable) Data() (string, error) {
+	value := r
+	if r.Status != StatusCompleted {
+		value = &RankTable{Status: r.Status}
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Pods returns the UIDs of the pods of the rank table one per line, it is empty until the rank table is
+// completed. It is written with hccl.json in the same ConfigMap so the kubelet updates both files at once
+func (r *RankTable) Pods() string {
+	if r.Status != StatusCompleted {
+		return ""
+	}
+	uids := []string{}
+	for _, server := range r.ServerList {
+		uids = append(uids, server.PodUID)
+	}
+	return strings.Join(uids, "\n")
+}
+
+// Task is a task requesting Ascend NPUs in the tasks annotation
+type Task struct {
+	Name     string
+	Replicas int
+}
+
+// ParseTasks parses the tasks annotation of a rank table ConfigMap, e.g. master:1,worker:4
+func ParseTasks(value string) ([]Task, error) {
+	tasks := []Task{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		nameReplicas := strings.SplitN(item, ":", 2)
+		if len(nameReplicas) != 2 || nameReplicas[0] == "" {
+			return nil, fmt.Errorf("invalid task %q, expected <name>:<replicas>", item)
+		}
+		replicas, err := strconv.Atoi(nameReplicas[1])
+		if err != nil || replicas < 0 {
+			return nil, fmt.Errorf("invalid replicas of task %q", item)
+		}
+		tasks = append(tasks, Task{Name: nameReplicas[0], Replicas: replicas})
+	}
+	return tasks, nil
+}
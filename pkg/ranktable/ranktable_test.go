@@ -0,0 +1,132 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranktable
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	volcanov1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+)
+
+func newAscendPod(name, task, index, serverID, podIP string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(name + "-uid"),
+			Labels:    map[string]string{volcanov1alpha1.JobNameKey: "test"},
+			Annotations: map[string]string{
+				volcanov1alpha1.TaskSpecKey: task,
+				taskIndexAnnotation:         index,
+				DeviceConfigAnnotation: `{"pod_name":"` + name + `","server_id":"` + serverID +
+					`","devices":[{"device_id":"0","device_ip":"192.168.100.1"},{"device_id":"1","device_ip":"192.168.100.2"}]}`,
+			},
+		},
+		Status: corev1.PodStatus{PodIP: podIP},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	tasks := []Task{{Name: "master", Replicas: 1}, {Name: "worker", Replicas: 2}}
+	worker1 := newAscendPod("test-worker-1", "worker", "1", "10.0.0.3", "172.16.0.3")
+	worker0 := newAscendPod("test-worker-0", "worker", "0", "10.0.0.2", "172.16.0.2")
+	master := newAscendPod("test-master-0", "master", "0", "10.0.0.1", "172.16.0.1")
+	pending := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "test-worker-2",
+		Annotations: map[string]string{volcanov1alpha1.TaskSpecKey: "worker", taskIndexAnnotation: "2"},
+	}}
+
+	rankTable := Build(membersOfTasks(tasks, []*corev1.Pod{worker1, pending, worker0}), 3)
+	if rankTable.Status != StatusInitializing || len(rankTable.ServerList) != 2 {
+		t.Fatalf("expected the rank table to be initializing with 2 servers, got %+v", rankTable)
+	}
+	if data, _ := rankTable.Data(); data != `{"status":"initializing"}` {
+		t.Errorf("expected only the status of an initializing rank table, got %v", data)
+	}
+	if podUIDs := rankTable.Pods(); podUIDs != "" {
+		t.Errorf("expected no pods of an initializing rank table, got %v", podUIDs)
+	}
+
+	rankTable = Build(membersOfTasks(tasks, []*corev1.Pod{worker1, master, worker0}), 3)
+	if rankTable.Status != StatusCompleted || rankTable.ServerCount != "3" {
+		t.Fatalf("expected the rank table to be completed with 3 servers, got %+v", rankTable)
+	}
+	pods := []string{}
+	for _, server := range rankTable.ServerList {
+		pods = append(pods, server.Pod)
+	}
+	if expected := []string{"test-master-0", "test-worker-0", "test-worker-1"}; !reflect.DeepEqual(pods, expected) {
+		t.Errorf("expected the servers ranked by task order then task index %v, got %v", expected, pods)
+	}
+	if expected := "test-master-0-uid\ntest-worker-0-uid\ntest-worker-1-uid"; rankTable.Pods() != expected {
+		t.Errorf("expected the pods of the completed rank table %q, got %q", expected, rankTable.Pods())
+	}
+	expected := Server{
+		Pod:         "test-worker-1",
+		PodUID:      "test-worker-1-uid",
+		ServerID:    "10.0.0.3",
+		ContainerIP: "172.16.0.3",
+		HostNicIP:   "reserve",
+		Devices: []Device{
+			{DeviceID: "0", DeviceIP: "192.168.100.1", RankID: "4"},
+			{DeviceID: "1", DeviceIP: "192.168.100.2", RankID: "5"},
+		},
+	}
+	if !reflect.DeepEqual(rankTable.ServerList[2], expected) {
+		t.Errorf("expected the last server %+v, got %+v", expected, rankTable.ServerList[2])
+	}
+	data, err := rankTable.Data()
+	if err != nil {
+		t.Fatalf("failed to marshal the rank table: %v", err)
+	}
+	if prefix := `{"status":"completed","version":"1.0","server_count":"3","server_list":[{"server_id":"10.0.0.1",`; data[:len(prefix)] != prefix {
+		t.Errorf("expected the rank table to start with %v, got %v", prefix, data)
+	}
+}
+
+func TestMembersOfTasks(t *testing.T) {
+	deleting := newAscendPod("test-worker-0", "worker", "0", "10.0.0.1", "")
+	deleting.DeletionTimestamp = &metav1.Time{}
+	pods := []*corev1.Pod{
+		deleting,
+		newAscendPod("test-worker-0", "worker", "0", "10.0.0.2", ""),
+		newAscendPod("test-launcher-0", "launcher", "0", "10.0.0.3", ""),
+		newAscendPod("test-worker-1", "worker", "one", "10.0.0.4", ""),
+	}
+	members := membersOfTasks([]Task{{Name: "worker", Replicas: 2}}, pods)
+	if len(members) != 1 || members[0].Pod != pods[1] {
+		t.Errorf("expected only the running worker with a task index, got %+v", members)
+	}
+}
+
+func TestParseTasks(t *testing.T) {
+	tasks, err := ParseTasks("master:1, worker:4,")
+	if err != nil {
+		t.Fatalf("failed to parse the tasks: %v", err)
+	}
+	if expected := []Task{{Name: "master", Replicas: 1}, {Name: "worker", Replicas: 4}}; !reflect.DeepEqual(tasks, expected) {
+		t.Errorf("expected tasks %+v, got %+v", expected, tasks)
+	}
+	for _, value := range []string{"worker", ":1", "worker:-1", "worker:many"} {
+		if _, err := ParseTasks(value); err == nil {
+			t.Errorf("expected tasks %q to be invalid", value)
+		}
+	}
+}
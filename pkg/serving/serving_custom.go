@@ -58,6 +58,9 @@ func SubmitCustomServingJob(namespace string, args *types.CustomServingArgs) (er
 	}
 	// the master is also considered as a worker
 	customChart := util.GetChartsFolder() + "/custom-serving"
+	if args.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(nameWithVersion, namespace, args, customChart, args.DryRun, args.HelmOptions...)
+	}
 	err = workflow.SubmitJob(nameWithVersion, string(types.CustomServingJob), namespace, args, customChart, args.HelmOptions...)
 	if err != nil {
 		return err
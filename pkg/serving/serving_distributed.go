@@ -73,6 +73,9 @@ func SubmitDistributedServingJob(namespace string, args *types.DistributedServin
 		return err
 	}
 	chart := util.GetChartsFolder() + "/distributed-serving"
+	if args.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(nameWithVersion, namespace, args, chart, args.DryRun, args.HelmOptions...)
+	}
 	err = workflow.SubmitJob(nameWithVersion, string(types.DistributedServingJob), namespace, args, chart, args.HelmOptions...)
 	if err != nil {
 		return err
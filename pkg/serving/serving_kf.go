@@ -58,6 +58,9 @@ func SubmitKFServingJob(namespace string, args *types.KFServingArgs) (err error)
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/kfserving"
+	if args.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(nameWithVersion, namespace, args, chart, args.DryRun, args.HelmOptions...)
+	}
 	err = workflow.SubmitJob(nameWithVersion, string(types.KFServingJob), namespace, args, chart, args.HelmOptions...)
 	if err != nil {
 		return err
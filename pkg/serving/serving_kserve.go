@@ -77,6 +77,9 @@ func SubmitKServeJob(namespace string, args *types.KServeArgs) (err error) {
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/kserve"
+	if args.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(args.Name, namespace, args, chart, args.DryRun, args.HelmOptions...)
+	}
 	err = workflow.SubmitJob(args.Name, string(types.KServeJob), namespace, args, chart, args.HelmOptions...)
 	if err != nil {
 		return err
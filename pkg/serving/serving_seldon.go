@@ -259,6 +259,9 @@ func SubmitSeldonServingJob(namespace string, args *types.SeldonServingArgs) (er
 	log.Infof("seldon chart path: %s", chart)
 	temp, _ := json.Marshal(args)
 	log.Infof("seldon args: %s", string(temp))
+	if args.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(nameWithVersion, namespace, args, chart, args.DryRun, args.HelmOptions...)
+	}
 	err = workflow.SubmitJob(nameWithVersion, string(types.SeldonServingJob), namespace, args, chart, args.HelmOptions...)
 	if err != nil {
 		return err
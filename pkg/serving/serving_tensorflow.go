@@ -58,6 +58,9 @@ func SubmitTensorflowServingJob(namespace string, args *types.TensorFlowServingA
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/tfserving"
+	if args.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(nameWithVersion, namespace, args, chart, args.DryRun, args.HelmOptions...)
+	}
 	err = workflow.SubmitJob(nameWithVersion, string(types.TFServingJob), namespace, args, chart, args.HelmOptions...)
 	if err != nil {
 		return err
@@ -58,6 +58,9 @@ func SubmitTensorRTServingJob(namespace string, args *types.TensorRTServingArgs)
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/trtserving"
+	if args.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(nameWithVersion, namespace, args, chart, args.DryRun, args.HelmOptions...)
+	}
 	err = workflow.SubmitJob(nameWithVersion, string(types.TRTServingJob), namespace, args, chart, args.HelmOptions...)
 	if err != nil {
 		return err
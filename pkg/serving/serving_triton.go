@@ -58,6 +58,9 @@ func SubmitTritonServingJob(namespace string, args *types.TritonServingArgs) (er
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/triton"
+	if args.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(nameWithVersion, namespace, args, chart, args.DryRun, args.HelmOptions...)
+	}
 	err = workflow.SubmitJob(nameWithVersion, string(types.TritonServingJob), namespace, args, chart, args.HelmOptions...)
 	if err != nil {
 		return err
@@ -0,0 +1,366 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	log "github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	appwrapperv1beta2 "github.com/kubeflow/arena/pkg/operators/appwrapper-operator/apis/appwrapper/v1beta2"
+	etv1alpha1 "github.com/kubeflow/arena/pkg/operators/et-operator/api/v1alpha1"
+	mpiv1alpha1 "github.com/kubeflow/arena/pkg/operators/mpi-operator/apis/kubeflow/v1alpha1"
+	pytorchv1 "github.com/kubeflow/arena/pkg/operators/pytorch-operator/apis/pytorch/v1"
+	tfv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/tensorflow/v1"
+	volcanov1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+)
+
+const (
+	volcanoTaskIndexAnnotation = "volcano.sh/task-index"
+	mpiRoleTypeLabel           = "mpi_role_type"
+	rayNodeTypeLabel           = "ray.io/node-type"
+	rayNodeGroupLabel          = "ray.io/group"
+	rayHeadRole                = "Head"
+	launcherRole               = "Launcher"
+	workerRole                 = "Worker"
+)
+
+// appWrapperComponent is an inner component of an AppWrapper decoded into its typed object,
+// at most one of the typed jobs is set
+type appWrapperComponent struct {
+	gvk         schema.GroupVersionKind
+	name        string
+	pytorchJob  *pytorchv1.PyTorchJob
+	volcanoJob  *volcanov1alpha1.Job
+	tfJob       *tfv1.TFJob
+	mpiJob      *mpiv1alpha1.MPIJob
+	trainingJob *etv1alpha1.TrainingJob
+	rayJob      *rayv1.RayJob
+	batchJob    *batchv1.Job
+	// podSets are the pod sets declared in the AppWrapper
+	podSets []appwrapperv1beta2.AppWrapperPodSet
+}
+
+// appWrapperRole is a replica type of a training job, a task of a Volcano Job,
+// a group of a RayJob or the pods of a batch Job
+type appWrapperRole struct {
+	name     string
+	replicas int32
+}
+
+// decodeAppWrapperComponents decodes the component templates of the AppWrapper, components
+// which can not be decoded are kept with their kind only
+func decodeAppWrapperComponents(appwrapper *appwrapperv1beta2.AppWrapper) []*appWrapperComponent {
+	components := []*appWrapperComponent{}
+	for i, component := range appwrapper.Spec.Components {
+		decoded, err := decodeAppWrapperComponent(component)
+		if err != nil {
+			log.Debugf("failed to decode component %d of AppWrapper %s: %v", i, appwrapper.Name, err)
+		}
+		components = append(components, decoded)
+	}
+	return components
+}
+
+func decodeAppWrapperComponent(component appwrapperv1beta2.AppWrapperComponent) (*appWrapperComponent, error) {
+	decoded := &appWrapperComponent{podSets: component.DeclaredPodSets}
+	raw := component.Template.Raw
+	if len(raw) == 0 && component.Template.Object != nil {
+		data, err := json.Marshal(component.Template.Object)
+		if err != nil {
+			return decoded, err
+		}
+		raw = data
+	}
+	if len(raw) == 0 {
+		return decoded, nil
+	}
+
+	var object metav1.PartialObjectMetadata
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return decoded, err
+	}
+	decoded.gvk = object.GroupVersionKind()
+	decoded.name = object.Name
+
+	var err error
+	switch {
+	case decoded.gvk.Group == pytorchv1.GroupName && decoded.gvk.Kind == pytorchv1.Kind:
+		decoded.pytorchJob = &pytorchv1.PyTorchJob{}
+		if err = json.Unmarshal(raw, decoded.pytorchJob); err != nil {
+			decoded.pytorchJob = nil
+		}
+	case decoded.gvk.Group == volcanov1alpha1.GroupName && decoded.gvk.Kind == "Job":
+		decoded.volcanoJob = &volcanov1alpha1.Job{}
+		if err = json.Unmarshal(raw, decoded.volcanoJob); err != nil {
+			decoded.volcanoJob = nil
+		}
+	case decoded.gvk.Group == tfv1.GroupName && decoded.gvk.Kind == tfv1.Kind:
+		decoded.tfJob = &tfv1.TFJob{}
+		if err = json.Unmarshal(raw, decoded.tfJob); err != nil {
+			decoded.tfJob = nil
+		}
+	case decoded.gvk == mpiv1alpha1.SchemeGroupVersionKind:
+		decoded.mpiJob = &mpiv1alpha1.MPIJob{}
+		if err = json.Unmarshal(raw, decoded.mpiJob); err != nil {
+			decoded.mpiJob = nil
+		}
+	case decoded.gvk.GroupKind() == etv1alpha1.SchemeGroupVersionKind.GroupKind():
+		decoded.trainingJob = &etv1alpha1.TrainingJob{}
+		if err = json.Unmarshal(raw, decoded.trainingJob); err != nil {
+			decoded.trainingJob = nil
+		}
+	case decoded.gvk.Group == rayv1.GroupVersion.Group && decoded.gvk.Kind == "RayJob":
+		decoded.rayJob = &rayv1.RayJob{}
+		if err = json.Unmarshal(raw, decoded.rayJob); err != nil {
+			decoded.rayJob = nil
+		}
+	case decoded.gvk.Group == batchv1.GroupName && decoded.gvk.Kind == "Job":
+		decoded.batchJob = &batchv1.Job{}
+		if err = json.Unmarshal(raw, decoded.batchJob); err != nil {
+			decoded.batchJob = nil
+		}
+	}
+	return decoded, err
+}
+
+// roles returns the replica roles declared in the spec of the component, ordered like the spec
+func (c *appWrapperComponent) roles() []appWrapperRole {
+	roles := []appWrapperRole{}
+	switch {
+	case c.pytorchJob != nil:
+		for _, replicaType := range []pytorchv1.PyTorchReplicaType{pytorchv1.PyTorchReplicaTypeMaster, pytorchv1.PyTorchReplicaTypeWorker} {
+			spec, ok := c.pytorchJob.Spec.PyTorchReplicaSpecs[replicaType]
+			if !ok || spec == nil {
+				continue
+			}
+			replicas := int32(1)
+			if spec.Replicas != nil {
+				replicas = *spec.Replicas
+			}
+			roles = append(roles, appWrapperRole{name: string(replicaType), replicas: replicas})
+		}
+	case c.volcanoJob != nil:
+		for _, task := range c.volcanoJob.Spec.Tasks {
+			roles = append(roles, appWrapperRole{name: task.Name, replicas: task.Replicas})
+		}
+	case c.tfJob != nil:
+		replicaTypes := []tfv1.TFReplicaType{
+			tfv1.TFReplicaTypeChief,
+			tfv1.TFReplicaTypeMaster,
+			tfv1.TFReplicaTypePS,
+			tfv1.TFReplicaTypeWorker,
+			tfv1.TFReplicaTypeEval,
+		}
+		for _, replicaType := range replicaTypes {
+			spec, ok := c.tfJob.Spec.TFReplicaSpecs[replicaType]
+			if !ok || spec == nil {
+				continue
+			}
+			roles = append(roles, appWrapperRole{name: string(replicaType), replicas: replicasOrOne(spec.Replicas)})
+		}
+	case c.mpiJob != nil:
+		roles = append(roles,
+			appWrapperRole{name: launcherRole, replicas: 1},
+			appWrapperRole{name: workerRole, replicas: replicasOrOne(c.mpiJob.Spec.Replicas)})
+	case c.trainingJob != nil:
+		roles = append(roles, appWrapperRole{name: launcherRole, replicas: 1})
+		if worker := c.trainingJob.Spec.ETReplicaSpecs.Worker; worker != nil {
+			roles = append(roles, appWrapperRole{name: workerRole, replicas: replicasOrOne(worker.Replicas)})
+		}
+	case c.rayJob != nil:
+		roles = append(roles, appWrapperRole{name: rayHeadRole, replicas: 1})
+		if c.rayJob.Spec.RayClusterSpec != nil {
+			for _, group := range c.rayJob.Spec.RayClusterSpec.WorkerGroupSpecs {
+				roles = append(roles, appWrapperRole{name: group.GroupName, replicas: replicasOrOne(group.Replicas)})
+			}
+		}
+	case c.batchJob != nil:
+		roles = append(roles, appWrapperRole{name: workerRole, replicas: replicasOrOne(c.batchJob.Spec.Parallelism)})
+	}
+	return roles
+}
+
+func replicasOrOne(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// cleanPodPolicy returns the clean pod policy of a PyTorchJob, TFJob, MPIJob or DeepSpeed component
+func (c *appWrapperComponent) cleanPodPolicy() string {
+	switch {
+	case c.pytorchJob != nil && c.pytorchJob.Spec.CleanPodPolicy != nil:
+		return string(*c.pytorchJob.Spec.CleanPodPolicy)
+	case c.tfJob != nil && c.tfJob.Spec.CleanPodPolicy != nil:
+		return string(*c.tfJob.Spec.CleanPodPolicy)
+	case c.mpiJob != nil && c.mpiJob.Spec.CleanPodPolicy != nil:
+		return string(*c.mpiJob.Spec.CleanPodPolicy)
+	case c.trainingJob != nil && c.trainingJob.Spec.CleanPodPolicy != nil:
+		return string(*c.trainingJob.Spec.CleanPodPolicy)
+	}
+	return ""
+}
+
+// expectedPods returns the number of the pods of the component, the launcher of an MPIJob
+// submitted with only the podSet of the workers is counted too
+func (c *appWrapperComponent) expectedPods() int32 {
+	if c.mpiJob != nil && len(c.podSets) == 1 {
+		return c.declaredReplicas() + 1
+	}
+	return c.declaredReplicas()
+}
+
+// declaredReplicas returns the sum of the replicas declared in the podSets of the component
+func (c *appWrapperComponent) declaredReplicas() int32 {
+	total := int32(0)
+	for _, podSet := range c.podSets {
+		if podSet.Replicas == nil {
+			total++
+			continue
+		}
+		total += *podSet.Replicas
+	}
+	return total
+}
+
+// roleOfPod returns the role and the index of the pod in the component, ok is false
+// if the pod does not belong to any role of the component
+func (c *appWrapperComponent) roleOfPod(pod *corev1.Pod) (role string, index string, ok bool) {
+	switch {
+	case c.pytorchJob != nil:
+		if !c.isJobOfPod(pod, labelPyTorchJobName) {
+			return "", "", false
+		}
+		replicaType := pod.Labels[TrainingReplicaTypeLabel]
+		index = pod.Labels[TrainingReplicaIndexLabel]
+		if replicaType == "" {
+			replicaType = pod.Labels[pytorchReplicaTypeLabel]
+			index = pod.Labels[pytorchReplicaIndexLabel]
+		}
+		for _, r := range c.roles() {
+			if strings.EqualFold(r.name, replicaType) {
+				return r.name, index, true
+			}
+		}
+	case c.volcanoJob != nil:
+		if jobName, found := pod.Labels[volcanov1alpha1.JobNameKey]; found && jobName != c.name {
+			return "", "", false
+		}
+		task := pod.Annotations[volcanov1alpha1.TaskSpecKey]
+		if task == "" {
+			task = pod.Labels[volcanov1alpha1.TaskSpecKey]
+		}
+		for _, r := range c.roles() {
+			if r.name == task {
+				return r.name, pod.Annotations[volcanoTaskIndexAnnotation], true
+			}
+		}
+	case c.tfJob != nil:
+		if !c.isJobOfPod(pod, labelTFJobName) {
+			return "", "", false
+		}
+		replicaType := pod.Labels[TrainingReplicaTypeLabel]
+		index = pod.Labels[TrainingReplicaIndexLabel]
+		if replicaType == "" {
+			replicaType = pod.Labels[tfReplicaTypeLabel]
+			index = pod.Labels[tfReplicaIndexLabel]
+		}
+		for _, r := range c.roles() {
+			if strings.EqualFold(r.name, replicaType) {
+				return r.name, index, true
+			}
+		}
+	case c.mpiJob != nil:
+		return launcherOrWorkerOfPod(pod, pod.Labels[mpiRoleTypeLabel])
+	case c.trainingJob != nil:
+		return launcherOrWorkerOfPod(pod, pod.Labels[deepspeedLabelTrainingJobRole])
+	case c.rayJob != nil:
+		switch pod.Labels[rayNodeTypeLabel] {
+		case "head":
+			return rayHeadRole, "0", true
+		case "worker":
+			return pod.Labels[rayNodeGroupLabel], "", true
+		}
+	case c.batchJob != nil:
+		if jobName, found := pod.Labels["job-name"]; found && jobName != c.name {
+			return "", "", false
+		}
+		return workerRole, pod.Annotations[batchv1.JobCompletionIndexAnnotation], true
+	}
+	return "", "", false
+}
+
+// isJobOfPod reports whether the pod is created for the training job of the component, the job name
+// is read from the training-operator label, then the given legacy label and at last the release label
+func (c *appWrapperComponent) isJobOfPod(pod *corev1.Pod, legacyJobNameLabel string) bool {
+	for _, label := range []string{TrainingJobNameLabel, legacyJobNameLabel, "release"} {
+		if jobName, found := pod.Labels[label]; found {
+			return jobName == c.name
+		}
+	}
+	return true
+}
+
+// launcherOrWorkerOfPod returns the role of a pod of an MPIJob or a DeepSpeed TrainingJob
+// from its role label, the index of a worker is the ordinal suffix of its name
+func launcherOrWorkerOfPod(pod *corev1.Pod, roleLabel string) (role string, index string, ok bool) {
+	switch roleLabel {
+	case "launcher":
+		return launcherRole, "0", true
+	case "worker":
+		ordinal := pod.Name[strings.LastIndex(pod.Name, "-")+1:]
+		if _, err := strconv.Atoi(ordinal); err != nil {
+			ordinal = ""
+		}
+		return workerRole, ordinal, true
+	}
+	return "", "", false
+}
+
+// isChiefRole returns true if the pod with the role and index is the chief of the component:
+// the PyTorch Master or TF Chief/Master (or the first Worker if there is none), the launcher
+// of an MPIJob or DeepSpeed job, the Ray head, the first pod of a batch Job, or the first pod
+// of the first Volcano task
+func (c *appWrapperComponent) isChiefRole(role, index string) bool {
+	roles := c.roles()
+	if len(roles) == 0 {
+		return false
+	}
+	switch {
+	case c.pytorchJob != nil && roles[0].name == string(pytorchv1.PyTorchReplicaTypeMaster):
+		return role == roles[0].name
+	case c.tfJob != nil:
+		for _, r := range roles {
+			if r.name == string(tfv1.TFReplicaTypeChief) || r.name == string(tfv1.TFReplicaTypeMaster) {
+				return role == r.name
+			}
+		}
+		return role == string(tfv1.TFReplicaTypeWorker) && index == "0"
+	case c.mpiJob != nil, c.trainingJob != nil:
+		return role == launcherRole
+	case c.rayJob != nil:
+		return role == rayHeadRole
+	}
+	return role == roles[0].name && index == "0"
+}
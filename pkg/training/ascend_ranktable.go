@@ -0,0 +1,101 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/ranktable"
+)
+
+const ascendResourcePrefix = "huawei.com/Ascend"
+
+// ascendRankTableOfComponent returns the rank table ConfigMap mounted by the tasks of a Volcano
+// component and the number of pods requesting Ascend NPUs, configMap is empty if none is mounted
+func ascendRankTableOfComponent(c *appWrapperComponent) (configMap string, expectedServers int32) {
+	if c.volcanoJob == nil {
+		return "", 0
+	}
+	for _, task := range c.volcanoJob.Spec.Tasks {
+		for _, volume := range task.Template.Spec.Volumes {
+			if volume.ConfigMap != nil && strings.HasPrefix(volume.ConfigMap.Name, ranktable.ConfigMapPrefix) {
+				configMap = volume.ConfigMap.Name
+			}
+		}
+		for _, container := range task.Template.Spec.Containers {
+			if requestsAscend(container) {
+				expectedServers += task.Replicas
+				break
+			}
+		}
+	}
+	if configMap == "" {
+		return "", 0
+	}
+	return configMap, expectedServers
+}
+
+func requestsAscend(container corev1.Container) bool {
+	for name := range container.Resources.Limits {
+		if strings.HasPrefix(string(name), ascendResourcePrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildAscendRankTable builds the rank table of a Volcano component from the device annotations
+// of its pods, the pods are ranked by task order then task index like the rank table publisher does
+func buildAscendRankTable(c *appWrapperComponent, pods []*corev1.Pod) *types.AscendRankTableInfo {
+	configMap, expectedServers := ascendRankTableOfComponent(c)
+	if configMap == "" {
+		return nil
+	}
+	taskOrder := map[string]int{}
+	for i, role := range c.roles() {
+		taskOrder[role.name] = i
+	}
+	members := []ranktable.Member{}
+	for _, pod := range pods {
+		task, index, ok := c.roleOfPod(pod)
+		if !ok {
+			continue
+		}
+		taskIndex, _ := strconv.Atoi(index)
+		members = append(members, ranktable.Member{Pod: pod, Task: taskOrder[task], Index: taskIndex})
+	}
+	built := ranktable.Build(members, int(expectedServers))
+
+	rankTable := &types.AscendRankTableInfo{
+		ConfigMap:       configMap,
+		Status:          built.Status,
+		ExpectedServers: expectedServers,
+	}
+	rank := 0
+	for _, server := range built.ServerList {
+		rankTable.Servers = append(rankTable.Servers, types.AscendRankTableServerInfo{
+			Pod:       server.Pod,
+			ServerID:  server.ServerID,
+			FirstRank: rank,
+			Devices:   len(server.Devices),
+		})
+		rank += len(server.Devices)
+	}
+	return rankTable
+}
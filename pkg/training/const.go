@@ -27,4 +27,6 @@ const (
 	TrainingReplicaTypeLabel = "training.kubeflow.org/replica-type"
 	// TrainingReplicaIndexLabel training-operator replica index label
 	TrainingReplicaIndexLabel = "training.kubeflow.org/replica-index"
+	// TrainingJobNameLabel training-operator job name label
+	TrainingJobNameLabel = "training.kubeflow.org/job-name"
 )
@@ -34,21 +34,25 @@ func DeleteTrainingJob(jobName, namespace string, jobType types.TrainingJobType)
 	// if the jobType is sure,delete the job
 	if jobType != types.AllTrainingJob {
 		canDelete, err := kubeclient.CheckJobIsOwnedByUser(namespace, jobName, jobType)
-		if err != nil {
-			if err == kubeclient.ErrConfigMapNotFound {
-				log.Errorf("The training job '%v' does not exist,skip to delete it", jobName)
-				return types.ErrTrainingJobNotFound
-			}
+		if err != nil && err != kubeclient.ErrConfigMapNotFound {
 			return err
 		}
-		if !canDelete {
+		if err == nil && !canDelete {
 			return types.ErrNoPrivilegesToOperateJob
 		}
-		return workflow.DeleteJob(jobName, namespace, string(jobType))
+		// the resources are discovered by labels if the configmap is missing
+		err = workflow.DeleteJob(jobName, namespace, string(jobType))
+		if err == types.ErrTrainingJobNotFound {
+			log.Errorf("The training job '%v' does not exist,skip to delete it", jobName)
+		}
+		return err
 	}
 	// 2. Handle training jobs created by arena
 	trainingTypes, err := getTrainingTypes(jobName, namespace)
 	if err != nil {
+		if err == types.ErrTrainingJobNotFound {
+			log.Infof("If the configmap of the job is lost, run `arena delete %s --type <JOB_TYPE>` to delete its resources by labels", jobName)
+		}
 		return err
 	}
 	err = workflow.DeleteJob(jobName, namespace, trainingTypes[0])
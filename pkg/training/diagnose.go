@@ -0,0 +1,215 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	kueueversioned "github.com/kubeflow/arena/pkg/operators/kueue-operator/client/clientset/versioned"
+	"github.com/kubeflow/arena/pkg/queue"
+)
+
+// maxMergedObjects is the max number of the objects printed in a merged finding
+const maxMergedObjects = 3
+
+// DiagnoseContext is the state of the training job shared by the checkers
+type DiagnoseContext struct {
+	Context context.Context
+	Job     TrainingJob
+	Pods    []*corev1.Pod
+	// Events are the events of the training job, its resources and its pods
+	Events    []*corev1.Event
+	Clientset kubernetes.Interface
+	Dynamic   dynamic.Interface
+	// Kueue is nil if kueue is not installed
+	Kueue kueueversioned.Interface
+}
+
+// JobChecker finds the problems of a training job
+type JobChecker interface {
+	// Name is the name of the checker, it is set to the findings
+	Name() string
+	// Check returns the problems found, an error means the checker can not run and its findings are skipped
+	Check(dc *DiagnoseContext) ([]types.DiagnoseFinding, error)
+}
+
+// jobCheckers are run in order, the findings of the same severity are ranked by the order of their checkers
+var jobCheckers = []JobChecker{
+	&schedulingChecker{},
+	&podGroupChecker{},
+	&kueueChecker{},
+	&appWrapperChecker{},
+	&imagePullChecker{},
+	&containerChecker{},
+	&dependencyChecker{},
+	&nodeChecker{},
+	&eventChecker{},
+}
+
+// RegisterJobChecker adds a checker which is run after the built-in checkers by DiagnoseTrainingJob
+func RegisterJobChecker(checker JobChecker) {
+	jobCheckers = append(jobCheckers, checker)
+}
+
+// DiagnoseTrainingJob runs the checkers over the training job and returns the findings ranked by severity
+func DiagnoseTrainingJob(jobName, namespace string, jobType types.TrainingJobType) (*types.DiagnoseResult, error) {
+	job, err := SearchTrainingJob(jobName, namespace, jobType)
+	if err != nil {
+		return nil, err
+	}
+	configer := config.GetArenaConfiger()
+	dynamicClient, err := dynamic.NewForConfig(configer.GetRestConfig())
+	if err != nil {
+		return nil, err
+	}
+	dc := &DiagnoseContext{
+		Context:   context.TODO(),
+		Job:       job,
+		Pods:      job.AllPods(),
+		Clientset: configer.GetClientSet(),
+		Dynamic:   dynamicClient,
+	}
+	if kueueClient, err := queue.GetKueueClient(); err == nil {
+		dc.Kueue = kueueClient
+	}
+	if dc.Events, err = listJobEvents(dc); err != nil {
+		log.Debugf("failed to list the events of job %v: %v", jobName, err)
+	}
+	return diagnose(dc, jobCheckers), nil
+}
+
+// diagnose runs the checkers, merges the same findings of different objects and ranks them by severity
+func diagnose(dc *DiagnoseContext, checkers []JobChecker) *types.DiagnoseResult {
+	result := &types.DiagnoseResult{
+		Name:      dc.Job.Name(),
+		Namespace: dc.Job.Namespace(),
+		Type:      dc.Job.Trainer(),
+		Status:    types.TrainingJobStatus(GetJobRealStatus(dc.Job)),
+		Findings:  []types.DiagnoseFinding{},
+	}
+	merged := map[string]int{}
+	objects := map[string][]string{}
+	for _, checker := range checkers {
+		findings, err := checker.Check(dc)
+		if err != nil {
+			log.Debugf("failed to run the checker %v: %v", checker.Name(), err)
+			continue
+		}
+		for _, finding := range findings {
+			finding.Checker = checker.Name()
+			key := strings.Join([]string{finding.Checker, string(finding.Severity), finding.Reason, finding.Message}, "/")
+			if i, ok := merged[key]; ok {
+				objects[key] = append(objects[key], finding.Object)
+				result.Findings[i].Object = joinObjects(objects[key])
+				continue
+			}
+			merged[key] = len(result.Findings)
+			objects[key] = []string{finding.Object}
+			result.Findings = append(result.Findings, finding)
+		}
+	}
+	sort.SliceStable(result.Findings, func(i, j int) bool {
+		return severityRank(result.Findings[i].Severity) < severityRank(result.Findings[j].Severity)
+	})
+	return result
+}
+
+// PrintDiagnoseResult prints the result in the format, one of json|yaml|wide
+func PrintDiagnoseResult(w io.Writer, result *types.DiagnoseResult, format string) error {
+	switch format {
+	case "json":
+		out, err := json.MarshalIndent(result, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(out))
+	case "yaml":
+		out, err := yaml.Marshal(result)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(w, string(out))
+	case "wide", "":
+		fmt.Fprintf(w, "Diagnosis of %v %v/%v (%v):\n\n", result.Type, result.Namespace, result.Name, result.Status)
+		if len(result.Findings) == 0 {
+			fmt.Fprintln(w, "No problem is found.")
+			return nil
+		}
+		for i, finding := range result.Findings {
+			fmt.Fprintf(w, "%d. [%v] %v: %v\n", i+1, finding.Severity, finding.Reason, finding.Object)
+			fmt.Fprintf(w, "   %v\n", finding.Message)
+			if finding.Suggestion != "" {
+				fmt.Fprintf(w, "   Suggestion: %v\n", finding.Suggestion)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown output format %v, only support: json|yaml|wide", format)
+	}
+	return nil
+}
+
+// listJobEvents returns the events of the job, its resources and its pods
+func listJobEvents(dc *DiagnoseContext) ([]*corev1.Event, error) {
+	names := map[string]bool{dc.Job.Name(): true}
+	for _, resource := range dc.Job.Resources() {
+		names[resource.Name] = true
+	}
+	for _, pod := range dc.Pods {
+		names[pod.Name] = true
+	}
+	eventList, err := dc.Clientset.CoreV1().Events(dc.Job.Namespace()).List(dc.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	events := []*corev1.Event{}
+	for i := range eventList.Items {
+		if names[eventList.Items[i].InvolvedObject.Name] {
+			events = append(events, &eventList.Items[i])
+		}
+	}
+	return events, nil
+}
+
+func severityRank(severity types.DiagnoseSeverity) int {
+	switch severity {
+	case types.DiagnoseCritical:
+		return 0
+	case types.DiagnoseWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func joinObjects(objects []string) string {
+	if len(objects) <= maxMergedObjects {
+		return strings.Join(objects, ", ")
+	}
+	return fmt.Sprintf("%v and %d more", strings.Join(objects[:maxMergedObjects], ", "), len(objects)-maxMergedObjects)
+}
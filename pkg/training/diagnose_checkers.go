@@ -0,0 +1,514 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	appwrapperv1beta2 "github.com/kubeflow/arena/pkg/operators/appwrapper-operator/apis/appwrapper/v1beta2"
+	"github.com/kubeflow/arena/pkg/queue"
+)
+
+// imagePullReasons are the waiting reasons of the containers whose images can not be pulled
+var imagePullReasons = map[string]bool{
+	"ErrImagePull":      true,
+	"ImagePullBackOff":  true,
+	"InvalidImageName":  true,
+	"ErrImageNeverPull": true,
+}
+
+// exitCodeHints explain the common exit codes of the containers
+var exitCodeHints = map[int32]string{
+	1:   "the application failed, check its logs with 'arena logs'",
+	2:   "the command is misused, check the command and its arguments",
+	126: "the command can not be executed, check its permission",
+	127: "the command is not found, check the command and the image",
+	134: "the process is aborted (SIGABRT), check the logs for assertion failures",
+	137: "the process is killed (SIGKILL), e.g. out of memory or preempted, check the memory limit",
+	139: "the process crashed with a segmentation fault (SIGSEGV), check the native libraries and drivers",
+	143: "the process is terminated (SIGTERM), e.g. evicted, preempted or deleted",
+}
+
+// schedulingChecker finds the pods which can not be scheduled, e.g. for insufficient resources or taints
+type schedulingChecker struct{}
+
+func (c *schedulingChecker) Name() string {
+	return "scheduling"
+}
+
+func (c *schedulingChecker) Check(dc *DiagnoseContext) ([]types.DiagnoseFinding, error) {
+	findings := []types.DiagnoseFinding{}
+	for _, pod := range dc.Pods {
+		if pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type != corev1.PodScheduled || cond.Status != corev1.ConditionFalse {
+				continue
+			}
+			suggestion := "check the node selector, affinity and tolerations of the job"
+			switch {
+			case strings.Contains(cond.Message, "Insufficient"):
+				suggestion = "reduce the requested resources of the job, or wait for the running jobs to release them with 'arena top node'"
+			case strings.Contains(cond.Message, "taint"):
+				suggestion = "add the tolerations of the node taints to the job with --toleration, or run it on other nodes"
+			case strings.Contains(cond.Message, "persistentvolumeclaim"):
+				suggestion = "check the persistent volume claims of the job are bound"
+			}
+			findings = append(findings, types.DiagnoseFinding{
+				Severity:   types.DiagnoseCritical,
+				Object:     "pod/" + pod.Name,
+				Reason:     cond.Reason,
+				Message:    cond.Message,
+				Suggestion: suggestion,
+			})
+		}
+	}
+	return findings, nil
+}
+
+// podGroupChecker finds the volcano PodGroup which can not be scheduled as a gang
+type podGroupChecker struct{}
+
+func (c *podGroupChecker) Name() string {
+	return "podgroup"
+}
+
+func (c *podGroupChecker) Check(dc *DiagnoseContext) ([]types.DiagnoseFinding, error) {
+	if dc.Dynamic == nil {
+		return nil, nil
+	}
+	// the pods of a gang which can not be scheduled may not be created yet
+	var candidates func() [][]string
+	switch job := dc.Job.(type) {
+	case *VolcanoJob:
+		candidates = func() [][]string {
+			return [][]string{podGroupNamesOfVolcanoJob(job.Name(), job.Uid())}
+		}
+	case *AppWrapperJob:
+		candidates = func() [][]string {
+			return podGroupNamesOfWrappedVolcanoJobs(dc.Dynamic, job.Namespace(), fmt.Sprintf("%s=%s", appWrapperLabelName, job.Name()))
+		}
+	}
+	findings := []types.DiagnoseFinding{}
+	for _, podGroup := range findPodGroups(dc.Dynamic, dc.Job.Namespace(), dc.Pods, candidates) {
+		for _, cond := range podGroup.Status.Conditions {
+			if cond.Type != podGroupUnschedulable || cond.Status != string(corev1.ConditionTrue) {
+				continue
+			}
+			findings = append(findings, types.DiagnoseFinding{
+				Severity: types.DiagnoseCritical,
+				Object:   "podgroup/" + podGroup.Metadata.Name,
+				Reason:   "PodGroupUnschedulable",
+				Message: fmt.Sprintf("the PodGroup needs %d pods to be scheduled together in queue %v: %v",
+					podGroup.Spec.MinMember, podGroup.Spec.Queue, cond.Message),
+				Suggestion: "check the capacity of the volcano queue and the free resources of the nodes, or reduce the replicas of the job",
+			})
+		}
+	}
+	return findings, nil
+}
+
+// kueueChecker finds the job whose kueue workload is not admitted, e.g. for the quota of the ClusterQueue
+type kueueChecker struct{}
+
+func (c *kueueChecker) Name() string {
+	return "kueue"
+}
+
+func (c *kueueChecker) Check(dc *DiagnoseContext) ([]types.DiagnoseFinding, error) {
+	var info *types.KueueWorkloadInfo
+	if job, ok := dc.Job.(*AppWrapperJob); ok {
+		info = job.KueueWorkload()
+	}
+	if info == nil && dc.Kueue != nil {
+		workloads, clusterQueues, err := queue.ListQueuedWorkloads(dc.Kueue, dc.Job.Namespace())
+		if err != nil {
+			return nil, err
+		}
+		info = queue.BuildWorkloadInfo(queue.WorkloadOfJob(k8stypes.UID(dc.Job.Uid()), workloads), workloads, clusterQueues)
+	}
+	if info == nil || info.Admitted {
+		return nil, nil
+	}
+	if status := types.TrainingJobStatus(GetJobRealStatus(dc.Job)); status != types.TrainingJobQueuing && status != types.TrainingJobPending {
+		return nil, nil
+	}
+	reason := info.PendingReason
+	if reason == "" {
+		reason = "NotAdmitted"
+	}
+	message := fmt.Sprintf("the workload is not admitted by kueue in the local queue %v", info.LocalQueue)
+	if info.QueuePosition > 0 {
+		message = fmt.Sprintf("%v at position %d", message, info.QueuePosition)
+	}
+	if info.PendingMessage != "" {
+		message = fmt.Sprintf("%v: %v", message, info.PendingMessage)
+	}
+	return []types.DiagnoseFinding{{
+		Severity:   types.DiagnoseCritical,
+		Object:     "workload/" + info.Name,
+		Reason:     reason,
+		Message:    message,
+		Suggestion: "check the quota of the ClusterQueue with 'arena queue list', reduce the requested resources or submit the job to another queue",
+	}}, nil
+}
+
+// appWrapperChecker finds the unhealthy and reset AppWrappers
+type appWrapperChecker struct{}
+
+func (c *appWrapperChecker) Name() string {
+	return "appwrapper"
+}
+
+func (c *appWrapperChecker) Check(dc *DiagnoseContext) ([]types.DiagnoseFinding, error) {
+	job, ok := dc.Job.(*AppWrapperJob)
+	if !ok {
+		return nil, nil
+	}
+	aw := job.appwrapper
+	findings := []types.DiagnoseFinding{}
+	for _, cond := range aw.Status.Conditions {
+		if cond.Type != appwrapperv1beta2.AppWrapperConditionUnhealthy || cond.Status != metav1.ConditionTrue {
+			continue
+		}
+		findings = append(findings, types.DiagnoseFinding{
+			Severity:   types.DiagnoseCritical,
+			Object:     "appwrapper/" + aw.Name,
+			Reason:     cond.Reason,
+			Message:    fmt.Sprintf("the AppWrapper is unhealthy: %v", cond.Message),
+			Suggestion: "check the failed pods of the job and their events, the AppWrapper is reset until its retry limit is reached",
+		})
+	}
+	if aw.Status.Retries > 0 {
+		findings = append(findings, types.DiagnoseFinding{
+			Severity:   types.DiagnoseWarning,
+			Object:     "appwrapper/" + aw.Name,
+			Reason:     "Reset",
+			Message:    fmt.Sprintf("the AppWrapper has been reset %d times", aw.Status.Retries),
+			Suggestion: "read the logs of the previous attempts with 'arena logs --attempt N' if the log archiver is enabled",
+		})
+	}
+	return findings, nil
+}
+
+// imagePullChecker finds the containers whose images can not be pulled
+type imagePullChecker struct{}
+
+func (c *imagePullChecker) Name() string {
+	return "image"
+}
+
+func (c *imagePullChecker) Check(dc *DiagnoseContext) ([]types.DiagnoseFinding, error) {
+	findings := []types.DiagnoseFinding{}
+	for _, pod := range dc.Pods {
+		for _, status := range podContainerStatuses(pod) {
+			waiting := status.State.Waiting
+			if waiting == nil || !imagePullReasons[waiting.Reason] {
+				continue
+			}
+			findings = append(findings, types.DiagnoseFinding{
+				Severity:   types.DiagnoseCritical,
+				Object:     "pod/" + pod.Name,
+				Reason:     waiting.Reason,
+				Message:    fmt.Sprintf("failed to pull the image %v of container %v", status.Image, status.Name),
+				Suggestion: "check the image name and tag, and set the image pull secrets with --image-pull-secret if the registry is private",
+			})
+		}
+	}
+	return findings, nil
+}
+
+// containerChecker finds the containers which are killed for out of memory, crash looping or failed
+type containerChecker struct{}
+
+func (c *containerChecker) Name() string {
+	return "container"
+}
+
+func (c *containerChecker) Check(dc *DiagnoseContext) ([]types.DiagnoseFinding, error) {
+	findings := []types.DiagnoseFinding{}
+	for _, pod := range dc.Pods {
+		for _, status := range podContainerStatuses(pod) {
+			terminated := status.State.Terminated
+			if terminated == nil {
+				terminated = status.LastTerminationState.Terminated
+			}
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+			finding := types.DiagnoseFinding{
+				Severity: types.DiagnoseCritical,
+				Object:   "pod/" + pod.Name,
+				Reason:   terminated.Reason,
+			}
+			crashLooping := status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff"
+			switch {
+			case terminated.Reason == "OOMKilled":
+				finding.Message = fmt.Sprintf("container %v is killed for out of memory, memory limit: %v",
+					status.Name, memoryLimitOf(pod, status.Name))
+				finding.Suggestion = "increase the memory limit of the job, or reduce the memory usage e.g. the batch size"
+			case crashLooping:
+				finding.Reason = "CrashLoopBackOff"
+				finding.Message = fmt.Sprintf("container %v keeps crashing with exit code %d", status.Name, terminated.ExitCode)
+				finding.Suggestion = exitCodeHint(terminated.ExitCode)
+			default:
+				if finding.Reason == "" {
+					finding.Reason = "Error"
+				}
+				finding.Message = fmt.Sprintf("container %v exited with code %d", status.Name, terminated.ExitCode)
+				finding.Suggestion = exitCodeHint(terminated.ExitCode)
+				// the container is restarted and running again
+				if status.State.Terminated == nil {
+					finding.Severity = types.DiagnoseWarning
+				}
+			}
+			findings = append(findings, finding)
+		}
+	}
+	return findings, nil
+}
+
+// dependencyChecker finds the persistent volume claims, secrets and configmaps used by the pods which are missing
+type dependencyChecker struct{}
+
+func (c *dependencyChecker) Name() string {
+	return "dependency"
+}
+
+func (c *dependencyChecker) Check(dc *DiagnoseContext) ([]types.DiagnoseFinding, error) {
+	namespace := dc.Job.Namespace()
+	checked := map[string]bool{}
+	findings := []types.DiagnoseFinding{}
+	missing := func(kind, name string, err error, severity types.DiagnoseSeverity, suggestion string) {
+		switch {
+		case k8serrors.IsNotFound(err):
+			findings = append(findings, types.DiagnoseFinding{
+				Severity:   severity,
+				Object:     kind + "/" + name,
+				Reason:     "NotFound",
+				Message:    fmt.Sprintf("the %v %v used by the job is not found in namespace %v", kind, name, namespace),
+				Suggestion: suggestion,
+			})
+		case err != nil:
+			findings = append(findings, types.DiagnoseFinding{
+				Severity: types.DiagnoseInfo,
+				Object:   kind + "/" + name,
+				Reason:   "Unknown",
+				Message:  fmt.Sprintf("failed to check the %v %v: %v", kind, name, err),
+			})
+		}
+	}
+	check := func(kind, name string, optional *bool) {
+		if name == "" || (optional != nil && *optional) || checked[kind+"/"+name] {
+			return
+		}
+		checked[kind+"/"+name] = true
+		switch kind {
+		case "persistentvolumeclaim":
+			pvc, err := dc.Clientset.CoreV1().PersistentVolumeClaims(namespace).Get(dc.Context, name, metav1.GetOptions{})
+			missing(kind, name, err, types.DiagnoseCritical, "create the persistent volume claim, or the data with 'arena data'")
+			if err == nil && pvc.Status.Phase == corev1.ClaimPending {
+				findings = append(findings, types.DiagnoseFinding{
+					Severity:   types.DiagnoseCritical,
+					Object:     kind + "/" + name,
+					Reason:     "Pending",
+					Message:    fmt.Sprintf("the persistent volume claim %v is not bound", name),
+					Suggestion: "check the storage class and the persistent volumes with 'kubectl describe pvc'",
+				})
+			}
+		case "secret":
+			_, err := dc.Clientset.CoreV1().Secrets(namespace).Get(dc.Context, name, metav1.GetOptions{})
+			missing(kind, name, err, types.DiagnoseCritical, "create the secret in the namespace of the job")
+		case "configmap":
+			_, err := dc.Clientset.CoreV1().ConfigMaps(namespace).Get(dc.Context, name, metav1.GetOptions{})
+			missing(kind, name, err, types.DiagnoseCritical, "create the configmap in the namespace of the job")
+		}
+	}
+	for _, pod := range dc.Pods {
+		for _, volume := range pod.Spec.Volumes {
+			switch {
+			case volume.PersistentVolumeClaim != nil:
+				check("persistentvolumeclaim", volume.PersistentVolumeClaim.ClaimName, nil)
+			case volume.Secret != nil:
+				check("secret", volume.Secret.SecretName, volume.Secret.Optional)
+			case volume.ConfigMap != nil:
+				check("configmap", volume.ConfigMap.Name, volume.ConfigMap.Optional)
+			}
+		}
+		containers := append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+		for _, container := range containers {
+			for _, env := range container.Env {
+				if env.ValueFrom == nil {
+					continue
+				}
+				if ref := env.ValueFrom.SecretKeyRef; ref != nil {
+					check("secret", ref.Name, ref.Optional)
+				}
+				if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil {
+					check("configmap", ref.Name, ref.Optional)
+				}
+			}
+			for _, envFrom := range container.EnvFrom {
+				if ref := envFrom.SecretRef; ref != nil {
+					check("secret", ref.Name, ref.Optional)
+				}
+				if ref := envFrom.ConfigMapRef; ref != nil {
+					check("configmap", ref.Name, ref.Optional)
+				}
+			}
+		}
+		for _, secret := range pod.Spec.ImagePullSecrets {
+			check("secret", secret.Name, nil)
+		}
+	}
+	return findings, nil
+}
+
+// nodeChecker finds the nodes of the pods which are not ready or not schedulable
+type nodeChecker struct{}
+
+func (c *nodeChecker) Name() string {
+	return "node"
+}
+
+func (c *nodeChecker) Check(dc *DiagnoseContext) ([]types.DiagnoseFinding, error) {
+	nodeNames := map[string]bool{}
+	for _, pod := range dc.Pods {
+		if pod.Spec.NodeName != "" {
+			nodeNames[pod.Spec.NodeName] = true
+		}
+	}
+	names := []string{}
+	for name := range nodeNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	findings := []types.DiagnoseFinding{}
+	for _, name := range names {
+		node, err := dc.Clientset.CoreV1().Nodes().Get(dc.Context, name, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				findings = append(findings, types.DiagnoseFinding{
+					Severity:   types.DiagnoseCritical,
+					Object:     "node/" + name,
+					Reason:     "NodeNotFound",
+					Message:    "the node of the pods is deleted",
+					Suggestion: "delete the pods on the node to reschedule them, or resubmit the job",
+				})
+			}
+			continue
+		}
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == corev1.NodeReady && cond.Status != corev1.ConditionTrue {
+				findings = append(findings, types.DiagnoseFinding{
+					Severity:   types.DiagnoseCritical,
+					Object:     "node/" + name,
+					Reason:     "NodeNotReady",
+					Message:    fmt.Sprintf("the node of the pods is not ready: %v", cond.Message),
+					Suggestion: "check the kubelet and the devices of the node, or cordon it and resubmit the job",
+				})
+			}
+		}
+		taints := []string{}
+		for _, taint := range node.Spec.Taints {
+			if taint.Effect == corev1.TaintEffectNoExecute {
+				taints = append(taints, taint.ToString())
+			}
+		}
+		if len(taints) != 0 {
+			findings = append(findings, types.DiagnoseFinding{
+				Severity:   types.DiagnoseWarning,
+				Object:     "node/" + name,
+				Reason:     "NoExecuteTaint",
+				Message:    fmt.Sprintf("the pods without tolerations are evicted from the node by the taints %v", strings.Join(taints, ", ")),
+				Suggestion: "check why the node is tainted, e.g. device failures reported by the device plugin",
+			})
+		}
+		if node.Spec.Unschedulable {
+			findings = append(findings, types.DiagnoseFinding{
+				Severity: types.DiagnoseInfo,
+				Object:   "node/" + name,
+				Reason:   "NodeCordoned",
+				Message:  "the node of the pods is cordoned, the recreated pods can not be scheduled to it",
+			})
+		}
+	}
+	return findings, nil
+}
+
+// eventChecker reports the warning events which are not explained by the other checkers
+type eventChecker struct{}
+
+func (c *eventChecker) Name() string {
+	return "event"
+}
+
+func (c *eventChecker) Check(dc *DiagnoseContext) ([]types.DiagnoseFinding, error) {
+	// the reasons explained by the other checkers
+	explained := map[string]bool{
+		"FailedScheduling": true,
+		"Failed":           true,
+		"BackOff":          true,
+	}
+	findings := []types.DiagnoseFinding{}
+	for _, event := range dc.Events {
+		if event.Type != corev1.EventTypeWarning || explained[event.Reason] {
+			continue
+		}
+		findings = append(findings, types.DiagnoseFinding{
+			Severity: types.DiagnoseInfo,
+			Object:   strings.ToLower(event.InvolvedObject.Kind) + "/" + event.InvolvedObject.Name,
+			Reason:   event.Reason,
+			Message:  event.Message,
+		})
+	}
+	return findings, nil
+}
+
+func podContainerStatuses(pod *corev1.Pod) []corev1.ContainerStatus {
+	return append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+}
+
+func memoryLimitOf(pod *corev1.Pod, containerName string) string {
+	containers := append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		if container.Name != containerName {
+			continue
+		}
+		if limit, ok := container.Resources.Limits[corev1.ResourceMemory]; ok {
+			return limit.String()
+		}
+	}
+	return "none"
+}
+
+func exitCodeHint(exitCode int32) string {
+	if hint, ok := exitCodeHints[exitCode]; ok {
+		return hint
+	}
+	if exitCode > 128 {
+		return fmt.Sprintf("the process is killed by signal %d, check the logs with 'arena logs'", exitCode-128)
+	}
+	return "check the logs of the container with 'arena logs'"
+}
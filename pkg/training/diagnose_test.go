@@ -0,0 +1,304 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	appwrapperv1beta2 "github.com/kubeflow/arena/pkg/operators/appwrapper-operator/apis/appwrapper/v1beta2"
+	kueuev1beta1 "github.com/kubeflow/arena/pkg/operators/kueue-operator/apis/kueue/v1beta1"
+	kueuefake "github.com/kubeflow/arena/pkg/operators/kueue-operator/client/clientset/versioned/fake"
+	"github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+)
+
+func newDiagnoseTestJob(phase appwrapperv1beta2.AppWrapperPhase, conditions []metav1.Condition, retries int32, pods []*corev1.Pod) *AppWrapperJob {
+	aw := &appwrapperv1beta2.AppWrapper{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "aw-uid"},
+		Status: appwrapperv1beta2.AppWrapperStatus{
+			Phase:      phase,
+			Conditions: conditions,
+			Retries:    retries,
+		},
+	}
+	return &AppWrapperJob{
+		BasicJobInfo: &BasicJobInfo{name: "test", resources: podResources(pods)},
+		appwrapper:   aw,
+		pods:         pods,
+		trainerType:  types.AppWrapperJob,
+	}
+}
+
+func newDiagnoseTestPod(name string, mutate func(pod *corev1.Pod)) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "worker"}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	mutate(pod)
+	return pod
+}
+
+func findingKeys(findings []types.DiagnoseFinding) []string {
+	keys := []string{}
+	for _, finding := range findings {
+		keys = append(keys, fmt.Sprintf("%v %v %v", finding.Severity, finding.Reason, finding.Object))
+	}
+	return keys
+}
+
+func TestDiagnoseFailedJob(t *testing.T) {
+	pods := []*corev1.Pod{
+		newDiagnoseTestPod("test-worker-0", func(pod *corev1.Pod) {
+			pod.Spec.NodeName = "node-a"
+			pod.Spec.Containers[0].Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+			pod.Status.Phase = corev1.PodFailed
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name:  "worker",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+			}}
+		}),
+		newDiagnoseTestPod("test-worker-1", func(pod *corev1.Pod) {
+			pod.Spec.NodeName = "node-b"
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name:                 "worker",
+				State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 139}},
+			}}
+		}),
+		newDiagnoseTestPod("test-worker-2", func(pod *corev1.Pod) {
+			pod.Status.Phase = corev1.PodPending
+			pod.Spec.Volumes = []corev1.Volume{
+				{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "training-data"}}},
+				{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "config"}}}},
+			}
+			pod.Spec.Containers[0].EnvFrom = []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "token"}}}}
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name:  "worker",
+				Image: "registry.example.com/train:v1",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+			}}
+		}),
+	}
+	job := newDiagnoseTestJob(appwrapperv1beta2.AppWrapperRunning, []metav1.Condition{{
+		Type:    appwrapperv1beta2.AppWrapperConditionUnhealthy,
+		Status:  metav1.ConditionTrue,
+		Reason:  "FoundFailedPods",
+		Message: "Found 1 failed pods",
+	}}, 2, pods)
+	clientset := fake.NewSimpleClientset(
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-a"},
+			Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-b"},
+			Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Message: "Kubelet stopped posting node status."}}},
+		},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "default"}},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "test.1", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "test-worker-2"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedMount",
+			Message:        `persistentvolumeclaim "training-data" not found`,
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "other.1", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "other-worker-0"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedMount",
+		},
+	)
+	dc := &DiagnoseContext{Context: context.TODO(), Job: job, Pods: pods, Clientset: clientset}
+	events, err := listJobEvents(dc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dc.Events = events
+
+	result := diagnose(dc, jobCheckers)
+	if result.Status != types.TrainingJobFailed {
+		t.Errorf("expected the job to be failed, got %v", result.Status)
+	}
+	expected := []string{
+		"CRITICAL FoundFailedPods appwrapper/test",
+		"CRITICAL ImagePullBackOff pod/test-worker-2",
+		"CRITICAL OOMKilled pod/test-worker-0",
+		"CRITICAL CrashLoopBackOff pod/test-worker-1",
+		"CRITICAL NotFound persistentvolumeclaim/training-data",
+		"CRITICAL NotFound secret/token",
+		"CRITICAL NodeNotReady node/node-b",
+		"WARNING Reset appwrapper/test",
+		"INFO FailedMount pod/test-worker-2",
+	}
+	if keys := findingKeys(result.Findings); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected the findings:\n%v\ngot:\n%v", strings.Join(expected, "\n"), strings.Join(keys, "\n"))
+	}
+	for _, finding := range result.Findings {
+		if finding.Reason == "OOMKilled" && !strings.Contains(finding.Message, "1Gi") {
+			t.Errorf("expected the memory limit in the message, got %q", finding.Message)
+		}
+		if finding.Reason == "CrashLoopBackOff" && finding.Suggestion != exitCodeHints[139] {
+			t.Errorf("expected the hint of exit code 139, got %q", finding.Suggestion)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := PrintDiagnoseResult(&out, result, "json"); err != nil {
+		t.Fatal(err)
+	}
+	decoded := &types.DiagnoseResult{}
+	if err := json.Unmarshal(out.Bytes(), decoded); err != nil || len(decoded.Findings) != len(expected) {
+		t.Errorf("expected the result in json, got %v: %v", out.String(), err)
+	}
+}
+
+func TestDiagnoseQueuingJob(t *testing.T) {
+	pods := []*corev1.Pod{}
+	for i := 0; i < 5; i++ {
+		pods = append(pods, newDiagnoseTestPod(fmt.Sprintf("test-worker-%d", i), func(pod *corev1.Pod) {
+			pod.Annotations = map[string]string{podGroupAnnotation: "test-pg"}
+			pod.Status.Phase = corev1.PodPending
+			pod.Status.Conditions = []corev1.PodCondition{{
+				Type:    corev1.PodScheduled,
+				Status:  corev1.ConditionFalse,
+				Reason:  corev1.PodReasonUnschedulable,
+				Message: "0/2 nodes are available: 2 Insufficient huawei.com/Ascend910.",
+			}}
+		}))
+	}
+	job := newDiagnoseTestJob(appwrapperv1beta2.AppWrapperSuspended, nil, 0, pods)
+	podGroup := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "scheduling.volcano.sh/v1beta1",
+		"kind":       "PodGroup",
+		"metadata":   map[string]interface{}{"name": "test-pg", "namespace": "default"},
+		"spec":       map[string]interface{}{"minMember": int64(5), "queue": "default"},
+		"status": map[string]interface{}{
+			"phase": "Pending",
+			"conditions": []interface{}{map[string]interface{}{
+				"type":    "Unschedulable",
+				"status":  "True",
+				"message": "5/5 tasks in gang unschedulable",
+			}},
+		},
+	}}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			podGroupResource: "PodGroupList",
+			v1alpha1.SchemeGroupVersion.WithResource("jobs"): "JobList",
+		}, podGroup)
+	kueueClient := kueuefake.NewSimpleClientset(&kueuev1beta1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "appwrapper-test",
+			Namespace: "default",
+			Labels:    map[string]string{kueuev1beta1.JobUIDLabel: "aw-uid"},
+		},
+		Spec: kueuev1beta1.WorkloadSpec{QueueName: "team-a"},
+		Status: kueuev1beta1.WorkloadStatus{Conditions: []metav1.Condition{{
+			Type:    kueuev1beta1.WorkloadQuotaReserved,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Pending",
+			Message: "couldn't assign flavors to pod set main: insufficient quota",
+		}}},
+	})
+	dc := &DiagnoseContext{
+		Context:   context.TODO(),
+		Job:       job,
+		Pods:      pods,
+		Clientset: fake.NewSimpleClientset(),
+		Dynamic:   dynamicClient,
+		Kueue:     kueueClient,
+	}
+	result := diagnose(dc, jobCheckers)
+	expected := []string{
+		"CRITICAL Unschedulable pod/test-worker-0, pod/test-worker-1, pod/test-worker-2 and 2 more",
+		"CRITICAL PodGroupUnschedulable podgroup/test-pg",
+		"CRITICAL Pending workload/appwrapper-test",
+	}
+	if keys := findingKeys(result.Findings); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected the findings:\n%v\ngot:\n%v", strings.Join(expected, "\n"), strings.Join(keys, "\n"))
+	}
+	if len(result.Findings) == 3 && !strings.Contains(result.Findings[2].Message, "team-a at position 1: couldn't assign flavors") {
+		t.Errorf("unexpected kueue finding message %q", result.Findings[2].Message)
+	}
+}
+
+func TestPodGroupCheckerWithoutPods(t *testing.T) {
+	unschedulable := []interface{}{map[string]interface{}{
+		"type":    "Unschedulable",
+		"status":  "True",
+		"message": "4/4 tasks in gang unschedulable",
+	}}
+	wrappedJob := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch.volcano.sh/v1alpha1",
+		"kind":       "Job",
+		"metadata": map[string]interface{}{
+			"name":      "wrapped",
+			"namespace": "default",
+			"uid":       "wrapped-uid",
+			"labels":    map[string]interface{}{appWrapperLabelName: "test"},
+		},
+	}}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			podGroupResource: "PodGroupList",
+			v1alpha1.SchemeGroupVersion.WithResource("jobs"): "JobList",
+		},
+		newTestPodGroup("vcjob-job-uid", "pg-1", "Pending", unschedulable...),
+		newTestPodGroup("wrapped-wrapped-uid", "pg-2", "Pending", unschedulable...),
+		wrappedJob,
+	)
+	volcanoJob := &VolcanoJob{
+		BasicJobInfo: &BasicJobInfo{name: "vcjob"},
+		volcanoJob: &v1alpha1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "vcjob", Namespace: "default", UID: "job-uid"},
+		},
+		trainerType: types.VolcanoTrainingJob,
+	}
+	tests := []struct {
+		job      TrainingJob
+		expected []string
+	}{
+		{job: volcanoJob, expected: []string{"CRITICAL PodGroupUnschedulable podgroup/vcjob-job-uid"}},
+		{job: newDiagnoseTestJob(appwrapperv1beta2.AppWrapperSuspended, nil, 0, nil), expected: []string{"CRITICAL PodGroupUnschedulable podgroup/wrapped-wrapped-uid"}},
+	}
+	for _, test := range tests {
+		findings, err := (&podGroupChecker{}).Check(&DiagnoseContext{Context: context.TODO(), Job: test.job, Dynamic: dynamicClient})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if keys := findingKeys(findings); !reflect.DeepEqual(keys, test.expected) {
+			t.Errorf("expected the findings of %v to be %v, got %v", test.job.Name(), test.expected, keys)
+		}
+	}
+}
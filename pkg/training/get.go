@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -31,9 +32,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
-	"github.com/kubeflow/arena/pkg/apis/config"
 	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
 	"github.com/kubeflow/arena/pkg/util"
 )
 
@@ -161,16 +162,15 @@ func PrintTrainingJob(job TrainingJob, modelVersion *types.ModelVersion, format
 	case "wide", "":
 		jobInfo := BuildJobInfo(job, showGPUs, services, nodes)
 		patchModelInfo(jobInfo, modelVersion)
-		printSingleJobHelper(jobInfo, job.Resources(), showEvents, showGPUs)
-		job.Resources()
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		printSingleJobHelper(w, jobInfo, job.Resources(), showEvents, showGPUs)
+		_ = w.Flush()
 	default:
 		log.Fatalf("Unknown output format: %s", format)
 	}
 }
 
-func printSingleJobHelper(job *types.TrainingJobInfo, resource []Resource, showEvents bool, showGPU bool) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-
+func printSingleJobHelper(w io.Writer, job *types.TrainingJobInfo, resource []Resource, showEvents bool, showGPU bool) {
 	lines := []string{"", "Instances:", "  NAME\tSTATUS\tAGE\tIS_CHIEF\tGPU(Requested)\tNODE"}
 	lines = append(lines, "  ----\t------\t---\t--------\t--------------\t----")
 	totalRequestGPUs := 0
@@ -204,6 +204,15 @@ func printSingleJobHelper(job *types.TrainingJobInfo, resource []Resource, showE
 	if job.Status != types.TrainingJobSucceeded {
 		lines = displayGPUUsage(lines, job.Status, totalAllocatedGPUs, totalRequestGPUs, job.Instances, showGPU)
 	}
+	if job.AppWrapper != nil {
+		lines = printAppWrapperStatus(lines, job.AppWrapper)
+	}
+	if job.Kueue != nil {
+		lines = printKueueWorkload(lines, job.Kueue)
+	}
+	if len(job.PodGroups) != 0 {
+		lines = printPodGroups(lines, job.PodGroups)
+	}
 	if job.Tensorboard != "" {
 		lines = append(lines, "", "Tensorboard:")
 		lines = append(lines, "  Your tensorboard will be available on: ")
@@ -227,6 +236,9 @@ func printSingleJobHelper(job *types.TrainingJobInfo, resource []Resource, showE
 	}
 	if job.Status == types.TrainingJobSucceeded || job.Status == types.TrainingJobFailed {
 		endTime = util.GetFormatTime(job.CreationTimestamp + duration)
+		if job.AppWrapper != nil && job.AppWrapper.FinishTime != 0 {
+			endTime = util.GetFormatTime(job.AppWrapper.FinishTime)
+		}
 	}
 	//startTime := time.Unix(job.CreationTimestamp, 0).Format("2006-01-02/15:04:05")
 	fmt.Fprintf(w, "Name:\t%v\n", job.Name)
@@ -234,7 +246,13 @@ func printSingleJobHelper(job *types.TrainingJobInfo, resource []Resource, showE
 	fmt.Fprintf(w, "Namespace:\t%v\n", job.Namespace)
 	fmt.Fprintf(w, "Priority:\t%v\n", job.Priority)
 	fmt.Fprintf(w, "Trainer:\t%v\n", strings.ToUpper(string(job.Trainer)))
+	if job.Accelerator != "" {
+		fmt.Fprintf(w, "Accelerator:\t%v\n", job.Accelerator)
+	}
 	fmt.Fprintf(w, "Duration:\t%v\n", util.ShortHumanDuration(time.Duration(duration)*time.Second))
+	if job.LastAdmittedAfter != "" {
+		fmt.Fprintf(w, "LastAdmittedAfter:\t%v\n", util.ShortHumanDuration(parseDurationSeconds(job.LastAdmittedAfter)))
+	}
 	fmt.Fprintf(w, "CreateTime:\t%v\n", util.GetFormatTime(job.CreationTimestamp))
 	fmt.Fprintf(w, "EndTime:\t%v\n", endTime)
 	if job.ModelName != "" {
@@ -247,13 +265,137 @@ func printSingleJobHelper(job *types.TrainingJobInfo, resource []Resource, showE
 		fmt.Fprintf(w, "ModelSource:\t%v\n", job.ModelSource)
 	}
 	fmt.Fprintf(w, "%v\n", strings.Join(lines, "\n"))
-	w.Flush()
+}
+
+// parseDurationSeconds parses the duration of TrainingJobInfo, e.g. 120s
+func parseDurationSeconds(value string) time.Duration {
+	seconds, err := strconv.ParseInt(strings.TrimSuffix(value, "s"), 10, 64)
+	if err != nil {
+		log.Debugf("failed to parse duration %v: %v", value, err)
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func printAppWrapperStatus(lines []string, status *types.AppWrapperStatusInfo) []string {
+	lines = append(lines, "", "AppWrapper:")
+	lines = append(lines, fmt.Sprintf("  Phase:\t%v", status.Phase))
+	lines = append(lines, fmt.Sprintf("  Retries:\t%v/%v", status.Retries, status.RetryLimit))
+	if status.AdmissionTime != 0 {
+		lines = append(lines, fmt.Sprintf("  AdmissionTime:\t%v", util.GetFormatTime(status.AdmissionTime)))
+	}
+	if status.RunStartTime != 0 {
+		lines = append(lines, fmt.Sprintf("  RunStartTime:\t%v", util.GetFormatTime(status.RunStartTime)))
+	}
+	if status.FinishTime != 0 {
+		lines = append(lines, fmt.Sprintf("  FinishTime:\t%v", util.GetFormatTime(status.FinishTime)))
+	}
+	if len(status.Conditions) > 0 {
+		lines = append(lines, "  Conditions:")
+		lines = append(lines, "    TIME\tTYPE\tSTATUS\tREASON\tMESSAGE")
+		lines = append(lines, "    ----\t----\t------\t------\t-------")
+		for _, cond := range status.Conditions {
+			lines = append(lines, fmt.Sprintf("    %v\t%v\t%v\t%v\t%v",
+				util.GetFormatTime(cond.LastTransitionTime),
+				cond.Type,
+				cond.Status,
+				cond.Reason,
+				cond.Message,
+			))
+		}
+	}
+	if len(status.Components) > 0 {
+		lines = append(lines, "  Components:")
+		lines = append(lines, "    KIND\tNAME\tPODS(Running/Declared)\tCONDITIONS")
+		lines = append(lines, "    ----\t----\t----------------------\t----------")
+		for _, component := range status.Components {
+			conditions := []string{}
+			for _, cond := range component.Conditions {
+				conditions = append(conditions, fmt.Sprintf("%v=%v", cond.Type, cond.Status))
+			}
+			if len(conditions) == 0 {
+				conditions = append(conditions, "N/A")
+			}
+			running := int32(0)
+			for _, replica := range component.Replicas {
+				running += replica.Running
+			}
+			lines = append(lines, fmt.Sprintf("    %v\t%v\t%v/%v\t%v", component.Kind, component.Name, running, component.ExpectedPods, strings.Join(conditions, ",")))
+		}
+		replicas := []string{}
+		for _, component := range status.Components {
+			for _, replica := range component.Replicas {
+				replicas = append(replicas, fmt.Sprintf("    %v/%v %v running", replica.Running, replica.Expected, pluralRole(replica.Role, replica.Expected)))
+			}
+			if component.CleanPodPolicy != "" {
+				replicas = append(replicas, fmt.Sprintf("    CleanPodPolicy: %v", component.CleanPodPolicy))
+			}
+		}
+		if len(replicas) > 0 {
+			lines = append(lines, "  Replicas:")
+			lines = append(lines, replicas...)
+		}
+	}
+	if rankTable := status.RankTable; rankTable != nil {
+		lines = append(lines, fmt.Sprintf("  RankTable:\t%v (%v/%v servers, ConfigMap %v)", rankTable.Status, len(rankTable.Servers), rankTable.ExpectedServers, rankTable.ConfigMap))
+		if len(rankTable.Servers) > 0 {
+			lines = append(lines, "    POD\tSERVER\tRANKS")
+			lines = append(lines, "    ---\t------\t-----")
+			for _, server := range rankTable.Servers {
+				lines = append(lines, fmt.Sprintf("    %v\t%v\t%v-%v", server.Pod, server.ServerID, server.FirstRank, server.FirstRank+server.Devices-1))
+			}
+		}
+	}
+	return lines
+}
+
+// pluralRole returns the lower case role name, in plural if there is more than one replica
+func pluralRole(role string, replicas int32) string {
+	role = strings.ToLower(role)
+	if replicas > 1 && !strings.HasSuffix(role, "s") {
+		role += "s"
+	}
+	return role
+}
+
+func printKueueWorkload(lines []string, workload *types.KueueWorkloadInfo) []string {
+	lines = append(lines, "", "Kueue:")
+	lines = append(lines, fmt.Sprintf("  Workload:\t%v", workload.Name))
+	lines = append(lines, fmt.Sprintf("  LocalQueue:\t%v", workload.LocalQueue))
+	if workload.ClusterQueue != "" {
+		lines = append(lines, fmt.Sprintf("  ClusterQueue:\t%v", workload.ClusterQueue))
+	}
+	lines = append(lines, fmt.Sprintf("  QuotaReserved:\t%v", workload.QuotaReserved))
+	lines = append(lines, fmt.Sprintf("  Admitted:\t%v", workload.Admitted))
+	if workload.PendingReason != "" {
+		lines = append(lines, fmt.Sprintf("  PendingReason:\t%v: %v", workload.PendingReason, workload.PendingMessage))
+	}
+	if workload.QueuePosition > 0 {
+		lines = append(lines, fmt.Sprintf("  QueuePosition:\t%v", workload.QueuePosition))
+	}
+	return lines
+}
+
+func printPodGroups(lines []string, podGroups []*types.PodGroupInfo) []string {
+	for _, podGroup := range podGroups {
+		lines = append(lines, "", "PodGroup:")
+		lines = append(lines, fmt.Sprintf("  Name:\t%v", podGroup.Name))
+		if podGroup.Queue != "" {
+			lines = append(lines, fmt.Sprintf("  Queue:\t%v", podGroup.Queue))
+		}
+		lines = append(lines, fmt.Sprintf("  Phase:\t%v", podGroup.Phase))
+		lines = append(lines, fmt.Sprintf("  MinMember:\t%v", podGroup.MinMember))
+		lines = append(lines, fmt.Sprintf("  Running/Succeeded/Failed:\t%v/%v/%v", podGroup.Running, podGroup.Succeeded, podGroup.Failed))
+		if podGroup.UnschedulableMessage != "" {
+			lines = append(lines, fmt.Sprintf("  Unschedulable:\t%v", podGroup.UnschedulableMessage))
+		}
+	}
+	return lines
 }
 
 func printEvents(lines []string, namespace string, resources []Resource) []string {
 	lines = append(lines, "", "Events:")
-	clientset := config.GetArenaConfiger().GetClientSet()
-	eventsMap, err := GetResourcesEvents(clientset, namespace, resources)
+	eventsMap, err := listResourcesEvents(namespace, resources)
 	if err != nil {
 		lines = append(lines, fmt.Sprintf("  Get job events failed, due to: %v", err))
 		return lines
@@ -286,15 +428,35 @@ func GetResourcesEvents(client *kubernetes.Clientset, namespace string, resource
 	if err != nil {
 		return eventMap, err
 	}
+	return groupResourcesEvents(events.Items, resources), nil
+}
+
+// listResourcesEvents is GetResourcesEvents on the events listed by the k8s resource accesser, which are
+// served from its cache in daemon mode
+func listResourcesEvents(namespace string, resources []Resource) (map[string][]corev1.Event, error) {
+	events, err := k8saccesser.GetK8sResourceAccesser().ListEvents(namespace)
+	if err != nil {
+		return map[string][]corev1.Event{}, err
+	}
+	items := []corev1.Event{}
+	for _, event := range events {
+		items = append(items, *event)
+	}
+	return groupResourcesEvents(items, resources), nil
+}
+
+// groupResourcesEvents groups the events by the name of the resources they involve
+func groupResourcesEvents(events []corev1.Event, resources []Resource) map[string][]corev1.Event {
+	eventMap := make(map[string][]corev1.Event)
 	for _, resource := range resources {
 		eventMap[resource.Name] = []corev1.Event{}
-		for _, event := range events.Items {
+		for _, event := range events {
 			if event.InvolvedObject.Kind == string(resource.ResourceType) && string(event.InvolvedObject.UID) == resource.Uid {
 				eventMap[resource.Name] = append(eventMap[resource.Name], event)
 			}
 		}
 	}
-	return eventMap, nil
+	return eventMap
 }
 
 func displayGPUUsage(lines []string, status types.TrainingJobStatus, totalAllocatedGPUs, totalRequestGPUs int, instances []types.TrainingJobInstance, showGPU bool) []string {
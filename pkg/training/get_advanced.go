@@ -106,7 +106,7 @@ func BuildJobInfo(job TrainingJob, showGPUs bool, services []*corev1.Service, no
 			nodeIP = pod.Status.HostIP
 			nodeName = pod.Spec.NodeName
 		}
-		count := utils.GPUCountInPod(pod)
+		_, count := utils.AcceleratorInPod(pod, acceleratorResourceNames())
 		count += utils.AliyunGPUCountInPod(pod)
 		instances = append(instances, types.TrainingJobInstance{
 			Name:              pod.Name,
@@ -136,12 +136,23 @@ func BuildJobInfo(job TrainingJob, showGPUs bool, services []*corev1.Service, no
 		Instances:    instances,
 		RequestGPU:   job.RequestedGPU(),
 		AllocatedGPU: job.AllocatedGPU(),
+		Accelerator:  acceleratorOfJob(job.AllPods()),
 	}
 
 	if job.StartTime() != nil {
 		trainingJobInfo.CreationTimestamp = job.StartTime().Unix()
 	}
 
+	if awJob, ok := job.(*AppWrapperJob); ok {
+		trainingJobInfo.Kueue = awJob.KueueWorkload()
+		trainingJobInfo.AppWrapper = awJob.StatusInfo()
+		trainingJobInfo.LastAdmittedAfter = fmt.Sprintf("%vs", int(awJob.LastAdmittedAfter().Seconds()))
+		trainingJobInfo.PodGroups = awJob.PodGroups()
+	}
+	if vcJob, ok := job.(*VolcanoJob); ok {
+		trainingJobInfo.PodGroups = vcJob.PodGroups()
+	}
+
 	return trainingJobInfo
 }
 
@@ -20,6 +20,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/kubeflow/arena/pkg/apis/config"
 	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/apis/utils"
 )
@@ -68,14 +69,35 @@ func gpuInActivePod(pod corev1.Pod) (gpuCount int64) {
 	return gpuCount
 }
 
+// gpuInContainer counts nvidia.com/gpu and the accelerator resources configured
+// by "acceleratorResources" in the arena config, e.g. huawei.com/Ascend910
 func gpuInContainer(container corev1.Container) int64 {
-	val, ok := container.Resources.Limits[NVIDIAGPUResourceName]
-
-	if !ok {
+	_, count := utils.AcceleratorInContainer(container, acceleratorResourceNames())
+	if count == 0 {
 		return gpuInContainerDeprecated(container)
 	}
 
-	return val.Value()
+	return count
+}
+
+// acceleratorOfJob returns the accelerator resource name requested by the pods of the job
+func acceleratorOfJob(pods []*corev1.Pod) string {
+	resourceNames := acceleratorResourceNames()
+	for _, pod := range pods {
+		if kind, count := utils.AcceleratorInPod(pod, resourceNames); count > 0 {
+			return kind
+		}
+	}
+	return ""
+}
+
+// acceleratorResourceNames returns the resources counted as gpus by the arena configer,
+// only nvidia.com/gpu is counted before the configer is initialized
+func acceleratorResourceNames() []string {
+	if !config.IsArenaConfigerInitialized() {
+		return []string{types.NvidiaGPUResourceName}
+	}
+	return config.GetArenaConfiger().GetAcceleratorResources()
 }
 
 func gpuInContainerDeprecated(container corev1.Container) int64 {
@@ -34,11 +34,31 @@ import (
 )
 
 func ListTrainingJobs(namespace string, allNamespaces bool, jobType types.TrainingJobType) ([]TrainingJob, error) {
-	jobs := []TrainingJob{}
-	trainers := GetAllTrainers()
 	if jobType == types.UnknownTrainingJob {
 		return nil, fmt.Errorf("unsupport job type,arena only supports: [%v]", utils.GetSupportTrainingJobTypesInfo())
 	}
+	trainers := map[types.TrainingJobType]Trainer{}
+	for trainerType, trainer := range GetAllTrainers() {
+		if isNeededTrainingType(trainerType, jobType) {
+			trainers[trainerType] = trainer
+		}
+	}
+	jobsOfTrainers, err := listJobsOfTrainers(trainers, namespace, allNamespaces)
+	if err != nil {
+		return nil, err
+	}
+	jobs := []TrainingJob{}
+	for _, trainerJobs := range jobsOfTrainers {
+		jobs = append(jobs, trainerJobs...)
+	}
+	jobs = makeTrainingJobOrderdByAge(jobs)
+	return jobs, nil
+}
+
+// listJobsOfTrainers lists the training jobs of the enabled trainers concurrently, the trainers failed to list
+// their jobs are left out of the result
+func listJobsOfTrainers(trainers map[types.TrainingJobType]Trainer, namespace string, allNamespaces bool) (map[types.TrainingJobType][]TrainingJob, error) {
+	jobs := map[types.TrainingJobType][]TrainingJob{}
 	var wg sync.WaitGroup
 	locker := new(sync.RWMutex)
 	noPrivileges := false
@@ -51,9 +71,6 @@ func ListTrainingJobs(namespace string, allNamespaces bool, jobType types.Traini
 			if !trainer.IsEnabled() {
 				return
 			}
-			if !isNeededTrainingType(trainerType, jobType) {
-				return
-			}
 			trainingJobs, err := trainer.ListTrainingJobs(namespace, allNamespaces)
 			if err != nil {
 				if strings.Contains(err.Error(), "forbidden: User") {
@@ -62,14 +79,16 @@ func ListTrainingJobs(namespace string, allNamespaces bool, jobType types.Traini
 						item = "all namespaces"
 					}
 					log.Debugf("the user has no privileges to list the %v in %v,reason: %v", trainerType, item, err)
+					locker.Lock()
 					noPrivileges = true
+					locker.Unlock()
 					return
 				}
 				log.Debugf("trainer %v failed to list training jobs: %v", trainerType, err)
 				return
 			}
 			locker.Lock()
-			jobs = append(jobs, trainingJobs...)
+			jobs[trainerType] = trainingJobs
 			locker.Unlock()
 		}()
 	}
@@ -81,7 +100,6 @@ func ListTrainingJobs(namespace string, allNamespaces bool, jobType types.Traini
 		}
 		return nil, fmt.Errorf("the user has no privileges to list the training jobs in %v", item)
 	}
-	jobs = makeTrainingJobOrderdByAge(jobs)
 	return jobs, nil
 }
 
@@ -108,47 +126,73 @@ func DisplayTrainingJobList(jobInfoList []TrainingJob, format string, allNamespa
 			jobInfos = append(jobInfos, BuildJobInfo(jobInfo, false, services, nodes))
 		}
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		header := []string{}
+		printTrainingJobTable(w, jobInfos, format, allNamespaces)
+		_ = w.Flush()
+		return
+
+	}
+}
+
+// printTrainingJobTable prints the training jobs as the table of arena list, the columns are separated by tabs
+func printTrainingJobTable(w io.Writer, jobInfos []*types.TrainingJobInfo, format string, allNamespaces bool) {
+	header := []string{}
+	if allNamespaces {
+		header = append(header, "NAMESPACE")
+	}
+	header = append(header, []string{"NAME", "STATUS", "TRAINER", "DURATION", "GPU(Requested)", "GPU(Allocated)", "NODE"}...)
+	if format == "wide" {
+		header = append(header, "ACCELERATOR", "QUEUE", "LAST_ADMITTED_AFTER")
+	}
+	PrintLine(w, header...)
+	for _, jobInfo := range jobInfos {
+		hostIP := "N/A"
+		for _, i := range jobInfo.Instances {
+			if i.IsChief {
+				hostIP = i.NodeIP
+			}
+		}
+		items := []string{}
 		if allNamespaces {
-			header = append(header, "NAMESPACE")
+			items = append(items, jobInfo.Namespace)
 		}
-		header = append(header, []string{"NAME", "STATUS", "TRAINER", "DURATION", "GPU(Requested)", "GPU(Allocated)", "NODE"}...)
-		PrintLine(w, header...)
-		for _, jobInfo := range jobInfos {
-			hostIP := "N/A"
-			for _, i := range jobInfo.Instances {
-				if i.IsChief {
-					hostIP = i.NodeIP
-				}
-			}
-			items := []string{}
-			if allNamespaces {
-				items = append(items, jobInfo.Namespace)
-			}
-			jobInfo.Duration = strings.ReplaceAll(jobInfo.Duration, "s", "")
-			duration, err := strconv.ParseInt(jobInfo.Duration, 10, 64)
-			if err != nil {
-				log.Debugf("failed to parse duration: %v", err)
+		jobInfo.Duration = strings.ReplaceAll(jobInfo.Duration, "s", "")
+		duration, err := strconv.ParseInt(jobInfo.Duration, 10, 64)
+		if err != nil {
+			log.Debugf("failed to parse duration: %v", err)
 
+		}
+		allocatedGPUs := "N/A"
+		if jobInfo.Status == types.TrainingJobPending || jobInfo.Status == types.TrainingJobRunning {
+			allocatedGPUs = fmt.Sprintf("%v", jobInfo.AllocatedGPU)
+		}
+		items = append(items, []string{
+			jobInfo.Name,
+			fmt.Sprintf("%v", jobInfo.Status),
+			strings.ToUpper(string(jobInfo.Trainer)),
+			util.ShortHumanDuration(time.Duration(duration) * time.Second),
+			fmt.Sprintf("%v", jobInfo.RequestGPU),
+			allocatedGPUs,
+			hostIP,
+		}...)
+		if format == "wide" {
+			accelerator := jobInfo.Accelerator
+			if accelerator == "" {
+				accelerator = "N/A"
+			}
+			queue := "N/A"
+			if jobInfo.Kueue != nil {
+				queue = jobInfo.Kueue.LocalQueue
+				if jobInfo.Kueue.QueuePosition > 0 {
+					queue = fmt.Sprintf("%v (#%v)", queue, jobInfo.Kueue.QueuePosition)
+				}
 			}
-			allocatedGPUs := "N/A"
-			if jobInfo.Status == types.TrainingJobPending || jobInfo.Status == types.TrainingJobRunning {
-				allocatedGPUs = fmt.Sprintf("%v", jobInfo.AllocatedGPU)
+			lastAdmittedAfter := "N/A"
+			if jobInfo.LastAdmittedAfter != "" {
+				lastAdmittedAfter = util.ShortHumanDuration(parseDurationSeconds(jobInfo.LastAdmittedAfter))
 			}
-			items = append(items, []string{
-				jobInfo.Name,
-				fmt.Sprintf("%v", jobInfo.Status),
-				strings.ToUpper(string(jobInfo.Trainer)),
-				util.ShortHumanDuration(time.Duration(duration) * time.Second),
-				fmt.Sprintf("%v", jobInfo.RequestGPU),
-				allocatedGPUs,
-				hostIP,
-			}...)
-			PrintLine(w, items...)
+			items = append(items, accelerator, queue, lastAdmittedAfter)
 		}
-		_ = w.Flush()
-		return
-
+		PrintLine(w, items...)
 	}
 }
 
@@ -15,16 +15,34 @@
 package training
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	podlogs "github.com/kubeflow/arena/pkg/podlogs"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kubeflow/arena/pkg/apis/config"
 	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/logarchive"
+	volcanov1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
 )
 
+// instanceRank is an instance of a training job with its role and global rank, the rank is -1 if it is unknown
+type instanceRank struct {
+	pod  *corev1.Pod
+	role string
+	rank int
+}
+
 // AcceptJobLog is used for arena-go-sdk
 func AcceptJobLog(jobName string, trainingType types.TrainingJobType, args *types.LogArgs) error {
 	namespace := args.Namespace
@@ -32,11 +50,24 @@ func AcceptJobLog(jobName string, trainingType types.TrainingJobType, args *type
 	if trainingType == types.UnknownTrainingJob {
 		return fmt.Errorf("unsupport job type,arena only supports: [%v]", utils.GetSupportTrainingJobTypesInfo())
 	}
+	// the archived logs are read from the log archiver, the job may be deleted already
+	if args.Attempt != nil {
+		return acceptArchivedJobLog(jobName, args)
+	}
 	// 2.search the training job
 	job, err := SearchTrainingJob(jobName, namespace, trainingType)
 	if err != nil {
 		return err
 	}
+	if args.AllInstances || args.Role != "" || args.Ranks != "" {
+		if args.InstanceName != "" {
+			return fmt.Errorf("--instance can not be used with --all, --role or --ranks")
+		}
+		return acceptJobInstancesLog(job, args)
+	}
+	if args.Merge || args.Grep != "" {
+		return fmt.Errorf("--merge and --grep are only supported with --all, --role or --ranks")
+	}
 	// 3.if instance name not set,set the chief pod name to instance name
 	if args.InstanceName == "" {
 		name, err := getInstanceName(job)
@@ -96,3 +127,304 @@ func moreThanOneInstanceHelpInfo(pods []*corev1.Pod) string {
 	}
 	return fmt.Sprintf("%s\n\n%s\n\n%s\n", header, strings.Join(lines, "\n"), footer)
 }
+
+// acceptArchivedJobLog prints the logs of an attempt of the job archived by the log archiver,
+// the logs of all the instances are printed if the instance is not set
+func acceptArchivedJobLog(jobName string, args *types.LogArgs) error {
+	if args.AllInstances || args.Role != "" || args.Ranks != "" || args.Merge || args.Follow {
+		return fmt.Errorf("--attempt can not be used with --all, --role, --ranks, --merge or --follow")
+	}
+	var grep *regexp.Regexp
+	if args.Grep != "" {
+		pattern, err := regexp.Compile(args.Grep)
+		if err != nil {
+			return fmt.Errorf("invalid --grep %v: %v", args.Grep, err)
+		}
+		grep = pattern
+	}
+	arenaNamespace := config.GetArenaConfiger().GetArenaNamespace()
+	services := config.GetArenaConfiger().GetClientSet().CoreV1().Services(arenaNamespace)
+	// the readers of the archived logs may only be allowed to proxy the service
+	if _, err := services.Get(context.TODO(), logarchive.ServiceName, metav1.GetOptions{}); err != nil && !k8serrors.IsForbidden(err) {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("not found the log archiver in namespace %v, please enable it by installing arena with --set logarchiver.enabled=true", arenaNamespace)
+		}
+		return err
+	}
+	params := map[string]string{}
+	if args.InstanceName != "" {
+		params["instance"] = args.InstanceName
+	}
+	path := fmt.Sprintf("logs/%v/%v/%v", args.Namespace, jobName, *args.Attempt)
+	content, err := getArchivedLogs(arenaNamespace, path, params)
+	if err != nil {
+		if k8serrors.IsForbidden(err) {
+			return fmt.Errorf("the user is not allowed to read the archived logs, it needs to get services/proxy of %v in namespace %v, "+
+				"please ask the administrator to bind the role %v to the user: %v", logarchive.ServiceName, arenaNamespace, logarchive.ReaderRoleName, err)
+		}
+		if !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get the archived logs of job %v: %v", jobName, err)
+		}
+		attempts := []int{}
+		raw, listErr := getArchivedLogs(arenaNamespace, fmt.Sprintf("attempts/%v/%v", args.Namespace, jobName), nil)
+		if listErr != nil || json.Unmarshal(raw, &attempts) != nil || len(attempts) == 0 {
+			return fmt.Errorf("not found the archived logs of job %v", jobName)
+		}
+		if args.InstanceName != "" {
+			return fmt.Errorf("not found the archived logs of instance %v in attempt %v of job %v, the archived attempts are %v", args.InstanceName, *args.Attempt, jobName, attempts)
+		}
+		return fmt.Errorf("not found the attempt %v of job %v, the archived attempts are %v", *args.Attempt, jobName, attempts)
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if grep != nil {
+		matched := []string{}
+		for _, line := range lines {
+			if grep.MatchString(line) {
+				matched = append(matched, line)
+			}
+		}
+		lines = matched
+	}
+	if args.Tail != nil && int(*args.Tail) < len(lines) {
+		lines = lines[len(lines)-int(*args.Tail):]
+	}
+	_, err = io.WriteString(args.WriterCloser, strings.Join(lines, ""))
+	return err
+}
+
+// getArchivedLogs gets the path from the log archiver through the service proxy of the api server,
+// which authorizes the user to get services/proxy of the log archiver
+func getArchivedLogs(arenaNamespace, path string, params map[string]string) ([]byte, error) {
+	request := config.GetArenaConfiger().GetClientSet().CoreV1().RESTClient().Get().
+		Namespace(arenaNamespace).
+		Resource("services").
+		Name(fmt.Sprintf("http:%v:%v", logarchive.ServiceName, logarchive.ServicePort)).
+		SubResource("proxy").
+		Suffix(path)
+	for key, value := range params {
+		request = request.Param(key, value)
+	}
+	return request.DoRaw(context.TODO())
+}
+
+// acceptJobInstancesLog streams the logs of the instances of the job selected by the role and ranks concurrently
+func acceptJobInstancesLog(job TrainingJob, args *types.LogArgs) error {
+	ranks, err := parseRanks(args.Ranks)
+	if err != nil {
+		return err
+	}
+	refresh := false
+	logger, err := podlogs.NewMultiPodLogger(args, func() ([]podlogs.LogSource, error) {
+		if refresh {
+			current, err := SearchTrainingJob(job.Name(), args.Namespace, job.Trainer())
+			if err != nil {
+				return nil, err
+			}
+			job = current
+		}
+		refresh = true
+		return selectLogSources(jobInstanceRanks(job.AllPods(), job.ChiefPod()), args.Role, ranks), nil
+	})
+	if err != nil {
+		return err
+	}
+	return logger.AcceptLogs()
+}
+
+// selectLogSources returns the instances of the role and ranks, all the instances are returned if they are not set
+func selectLogSources(instances []instanceRank, role string, ranks map[int]bool) []podlogs.LogSource {
+	sources := []podlogs.LogSource{}
+	for _, instance := range instances {
+		if role != "" && !strings.EqualFold(instance.role, role) {
+			continue
+		}
+		if ranks != nil && !ranks[instance.rank] {
+			continue
+		}
+		prefix := instance.pod.Name
+		if instance.rank >= 0 {
+			prefix = fmt.Sprintf("%v/%v", instance.pod.Name, instance.rank)
+		}
+		sources = append(sources, podlogs.LogSource{
+			PodName: instance.pod.Name,
+			PodUID:  string(instance.pod.UID),
+			Prefix:  prefix,
+		})
+	}
+	return sources
+}
+
+// jobInstanceRanks returns the instances ordered by their ranks. The rank of a pod is read from its RANK env, set by
+// the operators like the PyTorch operator and the charts like appwrapperjob, the pods without it have no rank. If no
+// pod has the env, the ranks are numbered from the role of the chief pod, e.g. the TF chief is rank 0 and the worker i
+// is rank i+1, the other roles follow in name order, and the MPI and DeepSpeed launchers have no rank
+func jobInstanceRanks(pods []*corev1.Pod, chief *corev1.Pod) []instanceRank {
+	instances := []instanceRank{}
+	envRanks := false
+	for _, pod := range pods {
+		role, _ := roleOfInstance(pod)
+		rank, ok := rankOfPod(pod)
+		envRanks = envRanks || ok
+		instances = append(instances, instanceRank{pod: pod, role: role, rank: rank})
+	}
+	if !envRanks {
+		numberInstanceRanks(instances, chief)
+	}
+	sort.SliceStable(instances, func(i, j int) bool {
+		if (instances[i].rank < 0) != (instances[j].rank < 0) {
+			return instances[i].rank >= 0
+		}
+		if instances[i].rank != instances[j].rank {
+			return instances[i].rank < instances[j].rank
+		}
+		return instances[i].pod.Name < instances[j].pod.Name
+	})
+	return instances
+}
+
+// numberInstanceRanks numbers the ranks of the instances by their roles and indexes in the roles
+func numberInstanceRanks(instances []instanceRank, chief *corev1.Pod) {
+	indexes := map[string]int{}
+	roleSizes := map[string]int{}
+	for _, instance := range instances {
+		_, index := roleOfInstance(instance.pod)
+		// the launcher starts the workers which are the ranks of the job
+		if instance.role == launcherRole {
+			index = -1
+		}
+		indexes[instance.pod.Name] = index
+		if index+1 > roleSizes[instance.role] {
+			roleSizes[instance.role] = index + 1
+		}
+	}
+	chiefRole := ""
+	if chief != nil {
+		chiefRole, _ = roleOfInstance(chief)
+	}
+	roles := []string{}
+	for role := range roleSizes {
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		if (roles[i] == chiefRole) != (roles[j] == chiefRole) {
+			return roles[i] == chiefRole
+		}
+		return roles[i] < roles[j]
+	})
+	offsets := map[string]int{}
+	offset := 0
+	for _, role := range roles {
+		offsets[role] = offset
+		offset += roleSizes[role]
+	}
+	for i := range instances {
+		instances[i].rank = -1
+		if index := indexes[instances[i].pod.Name]; index >= 0 {
+			instances[i].rank = offsets[instances[i].role] + index
+		}
+	}
+}
+
+// rankOfPod returns the rank of the pod from the RANK env of its containers. The multi-task jobs of the appwrapperjob
+// chart export RANK in the command as TASK_RANK_OFFSET + TASK_INDEX, which are read instead
+func rankOfPod(pod *corev1.Pod) (int, bool) {
+	for _, container := range pod.Spec.Containers {
+		envs := map[string]string{}
+		for _, env := range container.Env {
+			envs[env.Name] = envValueOfPod(pod, env)
+		}
+		if rank, err := strconv.Atoi(envs["RANK"]); err == nil && rank >= 0 {
+			return rank, true
+		}
+		offset, offsetErr := strconv.Atoi(envs["TASK_RANK_OFFSET"])
+		index, indexErr := strconv.Atoi(envs["TASK_INDEX"])
+		if offsetErr == nil && indexErr == nil && offset >= 0 && index >= 0 {
+			return offset + index, true
+		}
+	}
+	return -1, false
+}
+
+// envValueOfPod returns the value of the env, the values referring to the labels and annotations of the pod are resolved
+func envValueOfPod(pod *corev1.Pod, env corev1.EnvVar) string {
+	if env.ValueFrom == nil {
+		return env.Value
+	}
+	if env.ValueFrom.FieldRef == nil {
+		return ""
+	}
+	path := env.ValueFrom.FieldRef.FieldPath
+	if key, ok := strings.CutPrefix(path, "metadata.annotations['"); ok {
+		return pod.Annotations[strings.TrimSuffix(key, "']")]
+	}
+	if key, ok := strings.CutPrefix(path, "metadata.labels['"); ok {
+		return pod.Labels[strings.TrimSuffix(key, "']")]
+	}
+	return ""
+}
+
+// roleOfInstance returns the role and the index in the role of the pod from the labels of the operators,
+// the index is -1 if it is unknown
+func roleOfInstance(pod *corev1.Pod) (string, int) {
+	role, index := "", ""
+	switch {
+	case pod.Labels[TrainingReplicaTypeLabel] != "":
+		role, index = pod.Labels[TrainingReplicaTypeLabel], pod.Labels[TrainingReplicaIndexLabel]
+	case pod.Labels[pytorchReplicaTypeLabel] != "":
+		role, index = pod.Labels[pytorchReplicaTypeLabel], pod.Labels[pytorchReplicaIndexLabel]
+	case pod.Labels[tfReplicaTypeLabel] != "":
+		role, index = pod.Labels[tfReplicaTypeLabel], pod.Labels[tfReplicaIndexLabel]
+	case pod.Annotations[volcanov1alpha1.TaskSpecKey] != "" || pod.Labels[volcanov1alpha1.TaskSpecKey] != "":
+		role = pod.Annotations[volcanov1alpha1.TaskSpecKey]
+		if role == "" {
+			role = pod.Labels[volcanov1alpha1.TaskSpecKey]
+		}
+		index = pod.Annotations[volcanoTaskIndexAnnotation]
+		if index == "" {
+			index = pod.Name[strings.LastIndex(pod.Name, "-")+1:]
+		}
+	case pod.Labels[mpiRoleTypeLabel] != "":
+		role, index, _ = launcherOrWorkerOfPod(pod, pod.Labels[mpiRoleTypeLabel])
+	case pod.Labels[deepspeedLabelTrainingJobRole] != "":
+		role, index, _ = launcherOrWorkerOfPod(pod, pod.Labels[deepspeedLabelTrainingJobRole])
+	case pod.Labels[rayNodeTypeLabel] == "head":
+		role, index = rayHeadRole, "0"
+	case pod.Labels[rayNodeTypeLabel] == "worker":
+		role = pod.Labels[rayNodeGroupLabel]
+	}
+	i, err := strconv.Atoi(index)
+	if err != nil || i < 0 {
+		return role, -1
+	}
+	return role, i
+}
+
+// parseRanks parses the ranks like 0-7,9, nil is returned if the ranks are not set
+func parseRanks(value string) (map[int]bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+	ranks := map[int]bool{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		first, last, isRange := strings.Cut(item, "-")
+		start, err := strconv.Atoi(first)
+		if err != nil || start < 0 {
+			return nil, fmt.Errorf("invalid rank %q in --ranks %v, it should be like 0-7,9", item, value)
+		}
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(last)
+			if err != nil || end < start {
+				return nil, fmt.Errorf("invalid rank range %q in --ranks %v, it should be like 0-7,9", item, value)
+			}
+		}
+		for rank := start; rank <= end; rank++ {
+			ranks[rank] = true
+		}
+	}
+	return ranks, nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseRanks(t *testing.T) {
+	testcases := []struct {
+		value    string
+		expected map[int]bool
+		invalid  bool
+	}{
+		{value: "", expected: nil},
+		{value: "3", expected: map[int]bool{3: true}},
+		{value: "0-2, 5", expected: map[int]bool{0: true, 1: true, 2: true, 5: true}},
+		{value: "2-1", invalid: true},
+		{value: "a", invalid: true},
+		{value: "-1", invalid: true},
+	}
+	for _, tc := range testcases {
+		ranks, err := parseRanks(tc.value)
+		if tc.invalid {
+			if err == nil {
+				t.Errorf("expected --ranks %q to be invalid", tc.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("failed to parse --ranks %q: %v", tc.value, err)
+			continue
+		}
+		if !reflect.DeepEqual(ranks, tc.expected) {
+			t.Errorf("expected --ranks %q to be %v, got %v", tc.value, tc.expected, ranks)
+		}
+	}
+}
+
+func newRankTestPod(name string, labels, annotations map[string]string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, Annotations: annotations}}
+}
+
+func TestSelectLogSources(t *testing.T) {
+	pytorchPod := func(name, role, index string) *corev1.Pod {
+		return newRankTestPod(name, map[string]string{TrainingReplicaTypeLabel: role, TrainingReplicaIndexLabel: index}, nil)
+	}
+	master := pytorchPod("test-master-0", "master", "0")
+	pytorchPods := []*corev1.Pod{
+		pytorchPod("test-worker-1", "worker", "1"),
+		pytorchPod("test-worker-0", "worker", "0"),
+		master,
+		newRankTestPod("test-sidecar", nil, nil),
+	}
+	volcanoPod := func(name, task, index string) *corev1.Pod {
+		return newRankTestPod(name, nil, map[string]string{"volcano.sh/task-spec": task, volcanoTaskIndexAnnotation: index})
+	}
+	volcanoPods := []*corev1.Pod{
+		volcanoPod("test-worker-0", "worker", "0"),
+		volcanoPod("test-worker-1", "worker", "1"),
+		volcanoPod("test-worker-2", "worker", "2"),
+	}
+	mpiPod := func(name, role string) *corev1.Pod {
+		return newRankTestPod(name, map[string]string{mpiRoleTypeLabel: role}, nil)
+	}
+	mpiLauncher := mpiPod("test-launcher", "launcher")
+	mpiPods := []*corev1.Pod{
+		mpiPod("test-worker-1", "worker"),
+		mpiLauncher,
+		mpiPod("test-worker-0", "worker"),
+	}
+	// the tasks of the appwrapperjob chart are declared as trainer (2 replicas), evaluator and a coordinator
+	// out of the process group, the rank is exported as TASK_RANK_OFFSET + TASK_INDEX
+	appWrapperPod := func(name, task, index, offset string) *corev1.Pod {
+		pod := volcanoPod(name, task, index)
+		env := []corev1.EnvVar{{
+			Name:      "TASK_INDEX",
+			ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations['volcano.sh/task-index']"}},
+		}}
+		if offset != "" {
+			env = append(env, corev1.EnvVar{Name: "TASK_RANK_OFFSET", Value: offset})
+		}
+		pod.Spec.Containers = []corev1.Container{{Name: "main", Env: env}}
+		return pod
+	}
+	appWrapperPods := []*corev1.Pod{
+		appWrapperPod("test-evaluator-0", "evaluator", "0", "2"),
+		appWrapperPod("test-coordinator-0", "coordinator", "0", ""),
+		appWrapperPod("test-trainer-1", "trainer", "1", "0"),
+		appWrapperPod("test-trainer-0", "trainer", "0", "0"),
+	}
+	rankPod := func(name, role, index, rank string) *corev1.Pod {
+		pod := pytorchPod(name, role, index)
+		pod.Spec.Containers = []corev1.Container{{Name: "pytorch", Env: []corev1.EnvVar{{Name: "RANK", Value: rank}}}}
+		return pod
+	}
+	rankPods := []*corev1.Pod{
+		rankPod("test-worker-0", "worker", "0", "1"),
+		rankPod("test-master-0", "master", "0", "0"),
+	}
+	testcases := []struct {
+		name     string
+		pods     []*corev1.Pod
+		chief    *corev1.Pod
+		role     string
+		ranks    map[int]bool
+		expected []string
+	}{
+		{
+			name:     "all instances ordered by rank",
+			pods:     pytorchPods,
+			chief:    master,
+			expected: []string{"test-master-0/0", "test-worker-0/1", "test-worker-1/2", "test-sidecar"},
+		},
+		{
+			name:     "role",
+			pods:     pytorchPods,
+			chief:    master,
+			role:     "Worker",
+			expected: []string{"test-worker-0/1", "test-worker-1/2"},
+		},
+		{
+			name:     "ranks",
+			pods:     volcanoPods,
+			chief:    volcanoPods[0],
+			ranks:    map[int]bool{1: true, 2: true, 7: true},
+			expected: []string{"test-worker-1/1", "test-worker-2/2"},
+		},
+		{
+			name:     "the launcher has no rank",
+			pods:     mpiPods,
+			chief:    mpiLauncher,
+			expected: []string{"test-worker-0/0", "test-worker-1/1", "test-launcher"},
+		},
+		{
+			name:     "ranks in the declared task order",
+			pods:     appWrapperPods,
+			chief:    appWrapperPods[3],
+			expected: []string{"test-trainer-0/0", "test-trainer-1/1", "test-evaluator-0/2", "test-coordinator-0"},
+		},
+		{
+			name:     "rank env",
+			pods:     rankPods,
+			chief:    rankPods[1],
+			expected: []string{"test-master-0/0", "test-worker-0/1"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			prefixes := []string{}
+			for _, source := range selectLogSources(jobInstanceRanks(tc.pods, tc.chief), tc.role, tc.ranks) {
+				prefixes = append(prefixes, source.Prefix)
+			}
+			if !reflect.DeepEqual(prefixes, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, prefixes)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+)
+
+const (
+	// podGroupAnnotation is the annotation of the pods scheduled by volcano, its value is the name of the PodGroup
+	podGroupAnnotation = "scheduling.k8s.io/group-name"
+	// podGroupUnschedulable is the condition type of the PodGroup which can not be scheduled
+	podGroupUnschedulable = "Unschedulable"
+)
+
+var podGroupResource = schema.GroupVersionResource{Group: "scheduling.volcano.sh", Version: "v1beta1", Resource: "podgroups"}
+
+// volcanoPodGroup is the volcano PodGroup of the pods of a training job
+type volcanoPodGroup struct {
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	Spec     struct {
+		MinMember int32  `json:"minMember"`
+		Queue     string `json:"queue"`
+	} `json:"spec"`
+	Status struct {
+		Phase      string              `json:"phase"`
+		Running    int32               `json:"running"`
+		Succeeded  int32               `json:"succeeded"`
+		Failed     int32               `json:"failed"`
+		Conditions []podGroupCondition `json:"conditions"`
+	} `json:"status"`
+}
+
+type podGroupCondition struct {
+	Type               string      `json:"type"`
+	Status             string      `json:"status"`
+	Reason             string      `json:"reason"`
+	Message            string      `json:"message"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// getPodGroup returns the PodGroup, nil if it is not found
+func getPodGroup(ctx context.Context, client dynamic.Interface, namespace string, name string) (*volcanoPodGroup, error) {
+	obj, err := client.Resource(podGroupResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	podGroup := &volcanoPodGroup{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, podGroup); err != nil {
+		return nil, err
+	}
+	return podGroup, nil
+}
+
+// podGroupNamesOfPods returns the names of the PodGroups the pods are annotated with
+func podGroupNamesOfPods(pods []*corev1.Pod) []string {
+	names := []string{}
+	seen := map[string]bool{}
+	for _, pod := range pods {
+		name := pod.Annotations[podGroupAnnotation]
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// findPodGroups returns the PodGroups of the pods. The pods are not created until the gang can be scheduled,
+// so the PodGroups are also looked up by the names given by candidates, each element of which lists
+// the names the PodGroup of one volcano job may have. A PodGroup which can not be read is skipped with a warning
+func findPodGroups(client dynamic.Interface, namespace string, pods []*corev1.Pod, candidates func() [][]string) []*volcanoPodGroup {
+	if client == nil {
+		return nil
+	}
+	groups := [][]string{}
+	for _, name := range podGroupNamesOfPods(pods) {
+		groups = append(groups, []string{name})
+	}
+	if candidates != nil {
+		groups = append(groups, candidates()...)
+	}
+	podGroups := []*volcanoPodGroup{}
+	found := map[string]bool{}
+	for _, names := range groups {
+		for _, name := range names {
+			if found[name] {
+				break
+			}
+			podGroup, err := getPodGroup(context.TODO(), client, namespace, name)
+			if err != nil {
+				log.Warnf("failed to get the PodGroup %v/%v: %v", namespace, name, err)
+				continue
+			}
+			if podGroup != nil {
+				found[name] = true
+				podGroups = append(podGroups, podGroup)
+				break
+			}
+		}
+	}
+	return podGroups
+}
+
+// podGroupNamesOfVolcanoJob returns the names volcano may give to the PodGroup of the volcano job,
+// <job>-<uid> is used by the recent releases and <job> by the old ones
+func podGroupNamesOfVolcanoJob(name, uid string) []string {
+	if uid == "" {
+		return []string{name}
+	}
+	return []string{fmt.Sprintf("%v-%v", name, uid), name}
+}
+
+// podGroupNamesOfWrappedVolcanoJobs returns the names of the PodGroups of the volcano jobs matching the selector,
+// e.g. the volcano jobs wrapped by an appwrapper
+func podGroupNamesOfWrappedVolcanoJobs(client dynamic.Interface, namespace, selector string) [][]string {
+	jobs, err := client.Resource(v1alpha1.SchemeGroupVersion.WithResource("jobs")).Namespace(namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if k8serrors.IsNotFound(err) {
+		log.Debugf("failed to list the volcano jobs by %v: %v", selector, err)
+		return nil
+	}
+	if err != nil {
+		log.Warnf("failed to list the volcano jobs by %v: %v", selector, err)
+		return nil
+	}
+	names := [][]string{}
+	for _, job := range jobs.Items {
+		names = append(names, podGroupNamesOfVolcanoJob(job.GetName(), string(job.GetUID())))
+	}
+	return names
+}
+
+// resource returns the PodGroup as a resource of the training job, whose events are shown by arena get --events
+func (pg *volcanoPodGroup) resource() Resource {
+	return Resource{
+		Name:         pg.Metadata.Name,
+		Uid:          string(pg.Metadata.UID),
+		ResourceType: ResourceTypePodGroup,
+	}
+}
+
+// info converts the PodGroup to the PodGroupInfo shown by arena get
+func (pg *volcanoPodGroup) info() *types.PodGroupInfo {
+	info := &types.PodGroupInfo{
+		Name:      pg.Metadata.Name,
+		Queue:     pg.Spec.Queue,
+		Phase:     pg.Status.Phase,
+		MinMember: pg.Spec.MinMember,
+		Running:   pg.Status.Running,
+		Succeeded: pg.Status.Succeeded,
+		Failed:    pg.Status.Failed,
+	}
+	for _, cond := range pg.Status.Conditions {
+		if cond.Type == podGroupUnschedulable && cond.Status == string(corev1.ConditionTrue) {
+			info.UnschedulableMessage = cond.Message
+		}
+	}
+	return info
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+)
+
+func newTestPodGroup(name, uid, phase string, conditions ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "scheduling.volcano.sh/v1beta1",
+		"kind":       "PodGroup",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default", "uid": uid},
+		"spec":       map[string]interface{}{"minMember": int64(4), "queue": "team-a"},
+		"status": map[string]interface{}{
+			"phase":      phase,
+			"running":    int64(1),
+			"failed":     int64(2),
+			"conditions": conditions,
+		},
+	}}
+}
+
+func TestFindPodGroup(t *testing.T) {
+	unschedulable := map[string]interface{}{
+		"type":    "Unschedulable",
+		"status":  "True",
+		"message": "4/4 tasks in gang unschedulable: 0/2 nodes are available, 2 Insufficient nvidia.com/gpu",
+	}
+	wrappedJob := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "batch.volcano.sh/v1alpha1",
+			"kind":       "Job",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "default",
+				"uid":       name + "-uid",
+				"labels":    map[string]interface{}{appWrapperLabelName: "aw"},
+			},
+		}}
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			podGroupResource: "PodGroupList",
+			v1alpha1.SchemeGroupVersion.WithResource("jobs"): "JobList",
+		},
+		newTestPodGroup("annotated", "pg-1", "Running"),
+		newTestPodGroup("vcjob-job-uid", "pg-2", "Pending", unschedulable),
+		newTestPodGroup("legacy", "pg-3", "Inqueue"),
+		newTestPodGroup("wrapped-wrapped-uid", "pg-4", "Running"),
+		newTestPodGroup("second-second-uid", "pg-5", "Pending"),
+		wrappedJob("wrapped"),
+		wrappedJob("second"),
+	)
+	client.PrependReactor("get", "podgroups", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.(k8stesting.GetAction).GetName() == "forbidden" {
+			return true, nil, k8serrors.NewForbidden(podGroupResource.GroupResource(), "forbidden", nil)
+		}
+		return false, nil, nil
+	})
+	pod := func(name, podGroup string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{podGroupAnnotation: podGroup},
+		}}
+	}
+	wrapped := func() [][]string {
+		return podGroupNamesOfWrappedVolcanoJobs(client, "default", appWrapperLabelName+"=aw")
+	}
+
+	tests := []struct {
+		name       string
+		pods       []*corev1.Pod
+		candidates func() [][]string
+		expected   []string
+	}{
+		{
+			name:       "annotated pods",
+			pods:       []*corev1.Pod{pod("annotated-worker-0", "annotated"), pod("annotated-worker-1", "annotated")},
+			candidates: func() [][]string { return [][]string{{"annotated"}} },
+			expected:   []string{"annotated"},
+		},
+		{name: "volcano job", candidates: func() [][]string { return [][]string{podGroupNamesOfVolcanoJob("vcjob", "job-uid")} }, expected: []string{"vcjob-job-uid"}},
+		{name: "legacy volcano job", candidates: func() [][]string { return [][]string{podGroupNamesOfVolcanoJob("legacy", "legacy-uid")} }, expected: []string{"legacy"}},
+		{name: "wrapped volcano jobs", candidates: wrapped, expected: []string{"second-second-uid", "wrapped-wrapped-uid"}},
+		{
+			name:       "wrapped volcano jobs partly scheduled",
+			pods:       []*corev1.Pod{pod("wrapped-worker-0", "wrapped-wrapped-uid")},
+			candidates: wrapped,
+			expected:   []string{"wrapped-wrapped-uid", "second-second-uid"},
+		},
+		{name: "not found", candidates: func() [][]string { return [][]string{podGroupNamesOfVolcanoJob("unknown", "")} }},
+		{
+			name:       "read error",
+			candidates: func() [][]string { return [][]string{{"forbidden"}, podGroupNamesOfVolcanoJob("legacy", "")} },
+			expected:   []string{"legacy"},
+		},
+		{name: "no candidates"},
+	}
+	for _, test := range tests {
+		names := []string{}
+		for _, podGroup := range findPodGroups(client, "default", test.pods, test.candidates) {
+			names = append(names, podGroup.Metadata.Name)
+		}
+		if len(names) != len(test.expected) || (len(names) != 0 && !reflect.DeepEqual(names, test.expected)) {
+			t.Errorf("%v: expected PodGroups %v, got %v", test.name, test.expected, names)
+		}
+	}
+	if podGroups := findPodGroups(nil, "default", []*corev1.Pod{pod("annotated-worker-0", "annotated")}, nil); len(podGroups) != 0 {
+		t.Errorf("expected no PodGroup without the dynamic client, got %v", len(podGroups))
+	}
+
+	podGroups := findPodGroups(client, "default", nil, func() [][]string { return [][]string{podGroupNamesOfVolcanoJob("vcjob", "job-uid")} })
+	if len(podGroups) != 1 {
+		t.Fatalf("expected the PodGroup of the volcano job, got %v", len(podGroups))
+	}
+	podGroup := podGroups[0]
+	expected := &types.PodGroupInfo{
+		Name:                 "vcjob-job-uid",
+		Queue:                "team-a",
+		Phase:                "Pending",
+		MinMember:            4,
+		Running:              1,
+		Failed:               2,
+		UnschedulableMessage: unschedulable["message"].(string),
+	}
+	if info := podGroup.info(); !reflect.DeepEqual(info, expected) {
+		t.Errorf("expected PodGroup info %+v, got %+v", expected, info)
+	}
+
+	events := []corev1.Event{
+		{InvolvedObject: corev1.ObjectReference{Kind: "PodGroup", Name: "vcjob-job-uid", UID: "pg-2"}, Reason: "Unschedulable"},
+		{InvolvedObject: corev1.ObjectReference{Kind: "PodGroup", Name: "legacy", UID: "pg-3"}, Reason: "Unschedulable"},
+	}
+	grouped := groupResourcesEvents(events, []Resource{podGroup.resource()})
+	if len(grouped["vcjob-job-uid"]) != 1 || grouped["vcjob-job-uid"][0].InvolvedObject.UID != "pg-2" {
+		t.Errorf("expected the events of the PodGroup, got %v", grouped)
+	}
+}
@@ -16,47 +16,139 @@ package training
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/util"
 )
 
-func PruneTrainingJobs(namespace string, allNamespaces bool, since time.Duration) error {
-	jobs := []TrainingJob{}
-	trainers := GetAllTrainers()
-	for _, trainer := range trainers {
-		if !trainer.IsEnabled() {
+// pruneCandidate is a training job which may be pruned
+type pruneCandidate struct {
+	job    *types.PrunedTrainingJob
+	labels map[string]string
+	age    time.Duration
+}
+
+// PruneTrainingJobs deletes the not running training jobs selected by the args and returns them,
+// the jobs are returned without being deleted in dry run
+func PruneTrainingJobs(namespace string, args types.PruneArgs) ([]*types.PrunedTrainingJob, error) {
+	if args.Since < 0 && args.KeepLast <= 0 {
+		return nil, fmt.Errorf("you need to specify the relative duration live time of the job that need to be cleaned by --since or the number of jobs to keep by --keep-last. Like --since 10h")
+	}
+	for _, status := range args.Statuses {
+		if status == types.TrainingJobRunning {
+			return nil, fmt.Errorf("the running jobs can not be pruned")
+		}
+	}
+	selector := labels.Everything()
+	if args.LabelSelector != "" {
+		var err error
+		if selector, err = labels.Parse(args.LabelSelector); err != nil {
+			return nil, fmt.Errorf("invalid label selector %v: %v", args.LabelSelector, err)
+		}
+	}
+	jobType := args.JobType
+	if jobType == "" {
+		jobType = types.AllTrainingJob
+	}
+	jobs := map[string]TrainingJob{}
+	candidates := []pruneCandidate{}
+	for trainerType, trainer := range GetAllTrainers() {
+		if !isNeededTrainingType(trainerType, jobType) || !trainer.IsEnabled() {
 			continue
 		}
-		trainingJobs, err := trainer.ListTrainingJobs(namespace, allNamespaces)
+		trainingJobs, err := trainer.ListTrainingJobs(namespace, args.AllNamespaces)
 		if err != nil {
 			log.Debugf("failed to list jobs of tainer %v,reason: %v", trainer.Type(), err)
 			continue
 		}
-		jobs = append(jobs, trainingJobs...)
+		for _, job := range trainingJobs {
+			candidate := pruneCandidate{
+				job: &types.PrunedTrainingJob{
+					Name:      job.Name(),
+					Namespace: job.Namespace(),
+					Type:      job.Trainer(),
+					Status:    types.TrainingJobStatus(GetJobRealStatus(job)),
+					Age:       util.ShortHumanDuration(job.Age()),
+				},
+				labels: job.GetLabels(),
+				age:    job.Age(),
+			}
+			jobs[pruneKey(candidate.job)] = job
+			candidates = append(candidates, candidate)
+		}
 	}
-	deleted := false
-	if since == -1 {
-		return fmt.Errorf("you need to specify the relative duration live time of the job that need to be cleaned by --since. Like --since 10h")
+	pruned := selectPruneJobs(candidates, args, selector)
+	if args.DryRun {
+		return pruned, nil
 	}
-	for _, job := range jobs {
-		if GetJobRealStatus(job) == "RUNNING" {
+	deleted := []*types.PrunedTrainingJob{}
+	failures := []string{}
+	for _, prunedJob := range pruned {
+		job := jobs[pruneKey(prunedJob)]
+		if err := DeleteTrainingJob(job.Name(), job.Namespace(), job.Trainer()); err != nil {
+			failures = append(failures, fmt.Sprintf("failed to delete %s %s/%s: %v", job.Trainer(), job.Namespace(), job.Name(), err))
 			continue
 		}
-		if job.Age() < since {
+		deleted = append(deleted, prunedJob)
+	}
+	if len(failures) != 0 {
+		return deleted, fmt.Errorf("%v", strings.Join(failures, "\n"))
+	}
+	return deleted, nil
+}
+
+// selectPruneJobs returns the candidates to prune ordered from the oldest. The latest KeepLast
+// candidates of every user are kept, and then the ones younger than Since are kept
+func selectPruneJobs(candidates []pruneCandidate, args types.PruneArgs, selector labels.Selector) []*types.PrunedTrainingJob {
+	userId := ""
+	if args.User != "" {
+		userId = util.Md5(args.User)
+	}
+	statuses := map[types.TrainingJobStatus]bool{}
+	for _, status := range args.Statuses {
+		statuses[status] = true
+	}
+	matched := []pruneCandidate{}
+	for _, candidate := range candidates {
+		switch {
+		case candidate.job.Status == types.TrainingJobRunning:
+		case len(statuses) != 0 && !statuses[candidate.job.Status]:
+		case !selector.Matches(labels.Set(candidate.labels)):
+		case userId != "" && candidate.labels[types.UserNameIdLabel] != userId:
+		default:
+			matched = append(matched, candidate)
+		}
+	}
+	// the latest jobs come first
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].age < matched[j].age
+	})
+	kept := map[string]int{}
+	pruned := []*types.PrunedTrainingJob{}
+	for _, candidate := range matched {
+		user := candidate.labels[types.UserNameIdLabel]
+		if kept[user] < args.KeepLast {
+			kept[user]++
 			continue
 		}
-		deleted = true
-		fmt.Printf("Delete %s %s with Age %s \n", job.Trainer(), job.Name(), util.ShortHumanDuration(job.Age()))
-		err := DeleteTrainingJob(job.Name(), job.Namespace(), job.Trainer())
-		if err != nil {
-			fmt.Printf("Failed to delete %s %s, err: %++v", job.Trainer(), job.Name(), err)
+		if args.Since >= 0 && candidate.age < args.Since {
+			continue
 		}
+		pruned = append(pruned, candidate.job)
 	}
-	if !deleted {
-		fmt.Println("No job need to be deleted")
+	// the oldest jobs are pruned first
+	for i, j := 0, len(pruned)-1; i < j; i, j = i+1, j-1 {
+		pruned[i], pruned[j] = pruned[j], pruned[i]
 	}
-	return nil
+	return pruned
+}
+
+func pruneKey(job *types.PrunedTrainingJob) string {
+	return fmt.Sprintf("%v/%v/%v", job.Type, job.Namespace, job.Name)
 }
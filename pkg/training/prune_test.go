@@ -0,0 +1,101 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+)
+
+func TestSelectPruneJobs(t *testing.T) {
+	candidate := func(name string, status types.TrainingJobStatus, user string, age time.Duration, extra map[string]string) pruneCandidate {
+		jobLabels := map[string]string{types.UserNameIdLabel: util.Md5(user)}
+		for key, value := range extra {
+			jobLabels[key] = value
+		}
+		return pruneCandidate{
+			job:    &types.PrunedTrainingJob{Name: name, Status: status},
+			labels: jobLabels,
+			age:    age,
+		}
+	}
+	candidates := []pruneCandidate{
+		candidate("alice-1", types.TrainingJobFailed, "alice", 1*time.Hour, nil),
+		candidate("alice-2", types.TrainingJobSucceeded, "alice", 2*time.Hour, map[string]string{"team": "nlp"}),
+		candidate("alice-3", types.TrainingJobFailed, "alice", 3*time.Hour, map[string]string{"team": "nlp"}),
+		candidate("alice-4", types.TrainingJobRunning, "alice", 4*time.Hour, nil),
+		candidate("bob-1", types.TrainingJobFailed, "bob", 30*time.Minute, nil),
+		candidate("bob-2", types.TrainingJobPending, "bob", 5*time.Hour, nil),
+	}
+	testcases := []struct {
+		name     string
+		args     types.PruneArgs
+		selector string
+		expected []string
+	}{
+		{
+			name:     "since",
+			args:     types.PruneArgs{Since: 90 * time.Minute},
+			expected: []string{"bob-2", "alice-3", "alice-2"},
+		},
+		{
+			name:     "statuses",
+			args:     types.PruneArgs{Since: 0, Statuses: []types.TrainingJobStatus{types.TrainingJobFailed}},
+			expected: []string{"alice-3", "alice-1", "bob-1"},
+		},
+		{
+			name:     "label selector",
+			args:     types.PruneArgs{Since: 0},
+			selector: "team=nlp",
+			expected: []string{"alice-3", "alice-2"},
+		},
+		{
+			name:     "user",
+			args:     types.PruneArgs{Since: 0, User: "bob"},
+			expected: []string{"bob-2", "bob-1"},
+		},
+		{
+			name:     "keep last of every user",
+			args:     types.PruneArgs{Since: -1, KeepLast: 1},
+			expected: []string{"bob-2", "alice-3", "alice-2"},
+		},
+		{
+			name:     "keep last and since",
+			args:     types.PruneArgs{Since: 150 * time.Minute, KeepLast: 1},
+			expected: []string{"bob-2", "alice-3"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := labels.Parse(tc.selector)
+			if err != nil {
+				t.Fatal(err)
+			}
+			names := []string{}
+			for _, job := range selectPruneJobs(candidates, tc.args, selector) {
+				names = append(names, job.Name)
+			}
+			if !reflect.DeepEqual(names, tc.expected) {
+				t.Errorf("expected %v to be pruned, got %v", tc.expected, names)
+			}
+		})
+	}
+}
@@ -26,6 +26,8 @@ type ResourceType string
 const ResourceTypePod = ResourceType("Pod")
 const ResourceTypeStatefulSet = ResourceType("StatefulSet")
 const ResourceTypeJob = ResourceType("Job")
+const ResourceTypeWorkload = ResourceType("Workload")
+const ResourceTypePodGroup = ResourceType("PodGroup")
 
 func podResources(pods []*corev1.Pod) []Resource {
 	resources := []Resource{}
@@ -0,0 +1,330 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/strvals"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+	"github.com/kubeflow/arena/pkg/util"
+	"github.com/kubeflow/arena/pkg/util/helm"
+	"github.com/kubeflow/arena/pkg/util/kubeclient"
+	"github.com/kubeflow/arena/pkg/workflow"
+)
+
+// jobNameEnvs are the envs whose values are derived from the job name by the submit commands
+var jobNameEnvs = []string{"MASTER_ADDR", "HEAD_ADDR"}
+
+// stringMapValues are the values holding maps of strings, their overrides are never converted to
+// numbers or booleans so an env like EPOCHS=10 stays a string
+var stringMapValues = []string{
+	"envs", "annotations", "labels", "nodeSelectors", "devices", "dataset",
+	"launcherAnnotations", "workerAnnotations", "launcherSelectors", "tfNodeSelectors", "configFiles",
+}
+
+const (
+	// replaceDeletionPeriod is the period to check whether the resources of the replaced job are deleted
+	replaceDeletionPeriod = 2 * time.Second
+	// replaceDeletionTimeout is the max time to wait for the resources of the replaced job to be deleted
+	replaceDeletionTimeout = 5 * time.Minute
+)
+
+// storedJob is a training job loaded from the configmap kept by the submission
+type storedJob struct {
+	trainingType string
+	chartName    string
+	chartVersion string
+	values       string
+}
+
+// ResubmitTrainingJob submits the training job again with its stored values, the values can be
+// overridden by args.Sets and the job is submitted with a new name or after deleting the old one
+func ResubmitTrainingJob(jobName, namespace string, jobType types.TrainingJobType, args *types.ResubmitArgs) error {
+	job, err := getStoredJob(jobName, namespace, jobType)
+	if err != nil {
+		return err
+	}
+	newName := args.Name
+	if newName == "" {
+		newName = jobName
+	}
+	if err := util.ValidateJobName(newName); err != nil {
+		return err
+	}
+	replace := newName == jobName
+	if replace && !args.Replace {
+		return fmt.Errorf("the training job %s already exists, please set a new name with --name or delete it before resubmitting with --replace", jobName)
+	}
+	client := config.GetArenaConfiger().GetClientSet()
+	values, err := resubmitValues(client, namespace, job.values, jobName, newName, args.Sets)
+	if err != nil {
+		return err
+	}
+	chart := filepath.Join(util.GetChartsFolder(), job.chartName)
+	chartVersion, err := helm.GetChartVersion(chart)
+	if err != nil {
+		return fmt.Errorf("failed to load chart %s: %v", chart, err)
+	}
+	if chartVersion != job.chartVersion {
+		log.Warnf("the training job %s was submitted with chart %s-%s, it is resubmitted with version %s", jobName, job.chartName, job.chartVersion, chartVersion)
+	}
+	if args.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(newName, namespace, values, chart, args.DryRun)
+	}
+	if replace {
+		deletion := newJobDeletion(jobName, namespace, job.trainingType)
+		if err := DeleteTrainingJob(jobName, namespace, types.TrainingJobType(job.trainingType)); err != nil {
+			return fmt.Errorf("failed to delete the training job %s: %v", jobName, err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), replaceDeletionTimeout)
+		defer cancel()
+		if err := deletion.wait(ctx, replaceDeletionPeriod); err != nil {
+			return fmt.Errorf("failed to wait for the training job %s to be deleted: %v", jobName, err)
+		}
+	}
+	if err := workflow.SubmitJob(newName, job.trainingType, namespace, values, chart); err != nil {
+		return err
+	}
+	log.Infof("The Job %s has been resubmitted successfully", newName)
+	log.Infof("You can run `arena get %s --type %s -n %s` to check the job status", newName, job.trainingType, namespace)
+	return nil
+}
+
+// jobDeletion checks whether the resources of a deleted training job are gone, a replaced job is only
+// submitted again after it so its new resources do not conflict with the terminating ones
+type jobDeletion struct {
+	clientset kubernetes.Interface
+	namespace string
+	// pods are the pods of the job before it is deleted
+	pods []*corev1.Pod
+	// jobExists returns whether the training job still exists
+	jobExists func() (bool, error)
+}
+
+// newJobDeletion records the pods of the training job, it must be called before deleting the job
+func newJobDeletion(jobName, namespace, trainingType string) *jobDeletion {
+	deletion := &jobDeletion{
+		clientset: config.GetArenaConfiger().GetClientSet(),
+		namespace: namespace,
+		jobExists: func() (bool, error) {
+			_, err := getTrainingJobByType(jobName, namespace, trainingType)
+			if errors.Is(err, types.ErrTrainingJobNotFound) {
+				return false, nil
+			}
+			return err == nil, err
+		},
+	}
+	job, err := getTrainingJobByType(jobName, namespace, trainingType)
+	if err != nil {
+		log.Debugf("failed to get the pods of the training job %s, only wait for the job to be deleted: %v", jobName, err)
+		return deletion
+	}
+	deletion.pods = job.AllPods()
+	return deletion
+}
+
+// deleted returns whether the job and its pods are deleted, a pod recreated with the same name is not the old one
+func (d *jobDeletion) deleted(ctx context.Context) (bool, error) {
+	exists, err := d.jobExists()
+	if err != nil {
+		log.Debugf("failed to check whether the training job is deleted, retry later: %v", err)
+		return false, nil
+	}
+	if exists {
+		return false, nil
+	}
+	for _, pod := range d.pods {
+		current, err := d.clientset.CoreV1().Pods(d.namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			log.Debugf("failed to get the pod %s, retry later: %v", pod.Name, err)
+			return false, nil
+		}
+		if current.UID == pod.UID {
+			log.Debugf("the pod %s is not deleted yet", pod.Name)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// wait blocks until the job and its pods are deleted or the context is done
+func (d *jobDeletion) wait(ctx context.Context, period time.Duration) error {
+	return wait.PollUntilContextCancel(ctx, period, true, d.deleted)
+}
+
+// ExportTrainingJob returns the stored values of the training job as a spec file of option -f
+func ExportTrainingJob(jobName, namespace string, jobType types.TrainingJobType) ([]byte, error) {
+	job, err := getStoredJob(jobName, namespace, jobType)
+	if err != nil {
+		return nil, err
+	}
+	return specOfValues(jobName, job.values)
+}
+
+// specOfValues returns the spec file of the stored values, the values set by the builders are removed
+// since they are not accepted in a spec file
+func specOfValues(jobName, content string) ([]byte, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("failed to parse the stored values: %v", err)
+	}
+	if len(document.Content) > 0 && document.Content[0].Kind == yaml.MappingNode {
+		mapping := document.Content[0]
+		for i := 0; i+1 < len(mapping.Content); {
+			if util.StringInSlice(mapping.Content[i].Value, argsbuilder.BuilderKeys) {
+				mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+				continue
+			}
+			i += 2
+		}
+	}
+	values, err := yaml.Marshal(&document)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(fmt.Sprintf("name: %s\n%s", jobName, values)), nil
+}
+
+// getStoredJob loads the chart and values of the training job from the configmap <name>-<type>
+func getStoredJob(jobName, namespace string, jobType types.TrainingJobType) (*storedJob, error) {
+	if jobType == types.UnknownTrainingJob {
+		return nil, fmt.Errorf("unsupport job type,arena only supports: [%v]", utils.GetSupportTrainingJobTypesInfo())
+	}
+	trainingType := string(jobType)
+	if jobType == types.AllTrainingJob {
+		trainingTypes, err := getTrainingTypes(jobName, namespace)
+		if err != nil {
+			return nil, err
+		}
+		trainingType = trainingTypes[0]
+	}
+	configMap, err := kubeclient.GetConfigMap(namespace, fmt.Sprintf("%v-%v", jobName, trainingType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the stored values of the training job %s: %v", jobName, err)
+	}
+	job := &storedJob{trainingType: trainingType}
+	for key, value := range configMap.Data {
+		switch key {
+		case "values":
+			job.values = value
+		case "app":
+		default:
+			job.chartName, job.chartVersion = key, value
+		}
+	}
+	if job.chartName == "" {
+		return nil, fmt.Errorf("the chart of the training job %s is not found in its configmap", jobName)
+	}
+	return job, nil
+}
+
+// resubmitValues parses the stored values, renames the values derived from the job name, restores the
+// content of the config files and applies the overrides
+func resubmitValues(client kubernetes.Interface, namespace, content, oldName, newName string, sets []string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(content), &values); err != nil {
+		return nil, fmt.Errorf("failed to parse the stored values: %v", err)
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	if oldName != newName {
+		renameValues(values, oldName, newName)
+	}
+	if err := restoreConfigFiles(client, namespace, oldName, values); err != nil {
+		return nil, err
+	}
+	for _, set := range sets {
+		parse := strvals.ParseInto
+		key, _, _ := strings.Cut(set, ".")
+		key, _, _ = strings.Cut(key, "=")
+		if util.StringInSlice(key, stringMapValues) {
+			parse = strvals.ParseIntoString
+		}
+		if err := parse(set, values); err != nil {
+			return nil, fmt.Errorf("failed to parse --set %s: %v", set, err)
+		}
+	}
+	return values, nil
+}
+
+// restoreConfigFiles sets the content of the config files of option --config-file, the submission passes
+// it with --set-file so it is not in the stored values and it is read from the ConfigMaps of the job
+func restoreConfigFiles(client kubernetes.Interface, namespace, jobName string, values map[string]interface{}) error {
+	configFiles, ok := values["configFiles"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	for containerPathKey, item := range configFiles {
+		infos, ok := item.(map[string]interface{})
+		if !ok || len(infos) == 0 {
+			continue
+		}
+		name := fmt.Sprintf("%v-%v", jobName, containerPathKey)
+		configMap, err := client.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get the config files of the training job %s from configmap %s: %v", jobName, name, err)
+		}
+		for configFileKey, value := range infos {
+			info, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			fileName, _ := info["containerFileName"].(string)
+			content, found := configMap.Data[fileName]
+			if !found {
+				return fmt.Errorf("the config file %s of the training job %s is not found in configmap %s", configFileKey, jobName, name)
+			}
+			info["content"] = content
+		}
+	}
+	return nil
+}
+
+// renameValues replaces the job name in the pod group name and in the envs derived from it
+func renameValues(values map[string]interface{}, oldName, newName string) {
+	if podGroupName, ok := values["podGroupName"].(string); ok && strings.HasSuffix(podGroupName, "-"+oldName) {
+		values["podGroupName"] = strings.TrimSuffix(podGroupName, oldName) + newName
+	}
+	envs, ok := values["envs"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, key := range jobNameEnvs {
+		if value, ok := envs[key].(string); ok && strings.HasPrefix(value, oldName+"-") {
+			envs[key] = newName + strings.TrimPrefix(value, oldName)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestResubmitValues(t *testing.T) {
+	content := `image: pytorch:latest
+workers: 2
+podGroupName: pytorchjob-test
+envs:
+  MASTER_ADDR: test-master-0
+  LEARNING_RATE: "0.1"
+`
+	testcases := []struct {
+		name     string
+		newName  string
+		sets     []string
+		expected map[string]interface{}
+	}{
+		{
+			name:    "replace with overrides",
+			newName: "test",
+			sets:    []string{"workers=4", "envs.LEARNING_RATE=0.01", "envs.EPOCHS=10"},
+			expected: map[string]interface{}{
+				"image":        "pytorch:latest",
+				"workers":      int64(4),
+				"podGroupName": "pytorchjob-test",
+				"envs": map[string]interface{}{
+					"MASTER_ADDR":   "test-master-0",
+					"LEARNING_RATE": "0.01",
+					"EPOCHS":        "10",
+				},
+			},
+		},
+		{
+			name:    "new name",
+			newName: "test-2",
+			expected: map[string]interface{}{
+				"image":        "pytorch:latest",
+				"workers":      2,
+				"podGroupName": "pytorchjob-test-2",
+				"envs": map[string]interface{}{
+					"MASTER_ADDR":   "test-2-master-0",
+					"LEARNING_RATE": "0.1",
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			values, err := resubmitValues(fake.NewSimpleClientset(), "default", content, "test", tc.newName, tc.sets)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(values, tc.expected) {
+				t.Errorf("expected values %v, got %v", tc.expected, values)
+			}
+		})
+	}
+
+	if _, err := resubmitValues(fake.NewSimpleClientset(), "default", content, "test", "test", []string{"workers"}); err == nil {
+		t.Errorf("expected an error of an invalid --set")
+	}
+}
+
+func TestResubmitValuesWithConfigFiles(t *testing.T) {
+	content := `image: pytorch:latest
+configFiles:
+  etc-train:
+    config-0:
+      key: config-0
+      hostFile: /home/user/train.conf
+      containerFileName: train.conf
+      containerFilePath: /etc/train
+`
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-etc-train", Namespace: "default"},
+		Data:       map[string]string{"train.conf": "lr: 0.1\nepochs: 10"},
+	}
+	values, err := resubmitValues(fake.NewSimpleClientset(configMap), "default", content, "test", "test-2", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]interface{}{
+		"key":               "config-0",
+		"hostFile":          "/home/user/train.conf",
+		"containerFileName": "train.conf",
+		"containerFilePath": "/etc/train",
+		"content":           "lr: 0.1\nepochs: 10",
+	}
+	info := values["configFiles"].(map[string]interface{})["etc-train"].(map[string]interface{})["config-0"]
+	if !reflect.DeepEqual(info, expected) {
+		t.Errorf("expected the config file restored from the configmap of the job %v, got %v", expected, info)
+	}
+
+	values, err = resubmitValues(fake.NewSimpleClientset(configMap), "default", content, "test", "test",
+		[]string{"configFiles.etc-train.config-0.content=100"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content := values["configFiles"].(map[string]interface{})["etc-train"].(map[string]interface{})["config-0"].(map[string]interface{})["content"]; content != "100" {
+		t.Errorf("expected --set to override the content of the config file, got %v", content)
+	}
+
+	if _, err := resubmitValues(fake.NewSimpleClientset(), "default", content, "test", "test-2", nil); err == nil {
+		t.Errorf("expected an error when the configmap of the config files is gone")
+	}
+}
+
+func TestSpecOfValues(t *testing.T) {
+	spec, err := specOfValues("test", "image: pytorch:latest\ntrainingType: pytorchjob\nworkers: 2\npodGroupName: pytorchjob-test\n")
+	if err != nil {
+		t.Fatalf("failed to export the values: %v", err)
+	}
+	if expected := "name: test\nimage: pytorch:latest\nworkers: 2\n"; string(spec) != expected {
+		t.Errorf("expected the spec without the values set by the builders %q, got %q", expected, spec)
+	}
+}
+
+func TestJobDeletionWait(t *testing.T) {
+	pod := func(name, uid string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: k8stypes.UID(uid)}}
+	}
+	clientset := fake.NewSimpleClientset(pod("test-master-0", "master-0"), pod("test-worker-0", "recreated"))
+	checks := 0
+	deletion := &jobDeletion{
+		clientset: clientset,
+		namespace: "default",
+		pods:      []*corev1.Pod{pod("test-master-0", "master-0"), pod("test-worker-0", "worker-0")},
+		jobExists: func() (bool, error) {
+			checks++
+			switch checks {
+			case 1:
+				return true, nil
+			case 2:
+				return false, fmt.Errorf("connection refused")
+			case 4:
+				// the pod is deleted once the job is deleted and the old pod is checked again
+				if err := clientset.CoreV1().Pods("default").Delete(context.TODO(), "test-master-0", metav1.DeleteOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			return false, nil
+		},
+	}
+	if err := deletion.wait(context.Background(), time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if checks != 4 {
+		t.Errorf("expected the job to be resubmitted after its pods are deleted at the 4th check, got %v checks", checks)
+	}
+
+	clientset = fake.NewSimpleClientset(pod("test-master-0", "master-0"))
+	deletion = &jobDeletion{
+		clientset: clientset,
+		namespace: "default",
+		pods:      []*corev1.Pod{pod("test-master-0", "master-0")},
+		jobExists: func() (bool, error) { return false, nil },
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := deletion.wait(ctx, time.Millisecond); err == nil {
+		t.Errorf("expected an error while the pod of the job is not deleted")
+	}
+}
@@ -46,7 +46,9 @@ func SubmitAppWrapperJob(namespace string, submitArgs *types.SubmitAppWrapperJob
 	// For PyTorchJob, the master is also considered as a worker
 	// WorkerCount includes the master, so we subtract 1 to get actual workers
 	// For Volcano Job, WorkerCount is already synced from Replicas in argsbuilder
-	if submitArgs.InnerJobType != "volcano" {
+	// For the other inner types WorkerCount is the number of workers besides the
+	// chief, parameter servers, launcher or head, like their standalone jobs
+	if submitArgs.InnerJobType == "pytorch" {
 		if submitArgs.WorkerCount > 0 {
 			submitArgs.WorkerCount = submitArgs.WorkerCount - 1
 		}
@@ -54,6 +56,9 @@ func SubmitAppWrapperJob(namespace string, submitArgs *types.SubmitAppWrapperJob
 	}
 
 	appwrapperjobChart := util.GetChartsFolder() + "/appwrapperjob"
+	if submitArgs.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(submitArgs.Name, namespace, submitArgs, appwrapperjobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
+	}
 	err = workflow.SubmitJob(submitArgs.Name, string(types.AppWrapperJob), namespace, submitArgs, appwrapperjobChart, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
@@ -54,6 +54,9 @@ func SubmitDeepSpeedJob(namespace string, submitArgs *types.SubmitDeepSpeedJobAr
 	}
 	// the master is also considered as a worker
 	deepspeedjobChart := util.GetChartsFolder() + "/etjob"
+	if submitArgs.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(submitArgs.Name, namespace, submitArgs, deepspeedjobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
+	}
 	err = workflow.SubmitJob(submitArgs.Name, string(types.DeepSpeedTrainingJob), namespace, submitArgs, deepspeedjobChart, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
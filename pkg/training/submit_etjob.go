@@ -60,6 +60,9 @@ func SubmitETJob(namespace string, submitArgs *types.SubmitETJobArgs) (err error
 	}
 	// the master is also considered as a worker
 	etjobChart := util.GetChartsFolder() + "/etjob"
+	if submitArgs.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(submitArgs.Name, namespace, submitArgs, etjobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
+	}
 	err = workflow.SubmitJob(submitArgs.Name, string(types.ETTrainingJob), namespace, submitArgs, etjobChart, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
@@ -44,6 +44,9 @@ func SubmitHorovodJob(namespace string, submitArgs *types.SubmitHorovodJobArgs)
 	}
 	// the master is also considered as a worker
 	horovodTrainingChart := util.GetChartsFolder() + "/tf-horovod"
+	if submitArgs.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(submitArgs.Name, namespace, submitArgs, horovodTrainingChart, submitArgs.DryRun, submitArgs.HelmOptions...)
+	}
 	err = workflow.SubmitJob(submitArgs.Name, string(types.HorovodTrainingJob), namespace, submitArgs, horovodTrainingChart, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
@@ -44,6 +44,9 @@ func SubmitMPIJob(namespace string, submitArgs *types.SubmitMPIJobArgs) (err err
 	}
 	// the master is also considered as a worker
 	mpijobChart := util.GetChartsFolder() + "/mpijob"
+	if submitArgs.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(submitArgs.Name, namespace, submitArgs, mpijobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
+	}
 	err = workflow.SubmitJob(submitArgs.Name, string(types.MPITrainingJob), namespace, submitArgs, mpijobChart, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
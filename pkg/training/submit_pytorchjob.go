@@ -50,6 +50,9 @@ func SubmitPytorchJob(namespace string, submitArgs *types.SubmitPyTorchJobArgs)
 	submitArgs.TrainingOperatorCRD = compatible
 
 	pytorchjobChart := util.GetChartsFolder() + "/pytorchjob"
+	if submitArgs.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(submitArgs.Name, namespace, submitArgs, pytorchjobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
+	}
 	err = workflow.SubmitJob(submitArgs.Name, string(types.PytorchTrainingJob), namespace, submitArgs, pytorchjobChart, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
@@ -44,6 +44,9 @@ func SubmitRayJob(namespace string, submitArgs *types.SubmitRayJobArgs) (err err
 	}
 
 	rayjobChart := util.GetChartsFolder() + "/rayjob"
+	if submitArgs.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(submitArgs.Name, namespace, submitArgs, rayjobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
+	}
 	err = workflow.SubmitJob(submitArgs.Name, string(types.RayJob), namespace, submitArgs, rayjobChart, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
@@ -43,6 +43,9 @@ func SubmitSparkJob(namespace string, submitArgs *types.SubmitSparkJobArgs) (err
 		return err
 	}
 	sparkChart := util.GetChartsFolder() + "/sparkjob"
+	if submitArgs.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(submitArgs.Name, namespace, submitArgs, sparkChart, submitArgs.DryRun)
+	}
 	err = workflow.SubmitJob(submitArgs.Name, string(types.SparkTrainingJob), namespace, submitArgs, sparkChart)
 	if err != nil {
 		return err
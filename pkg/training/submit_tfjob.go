@@ -55,6 +55,9 @@ func SubmitTFJob(namespace string, submitArgs *types.SubmitTFJobArgs) (err error
 	compatible := CompatibleJobCRD(k8saccesser.TensorflowCRDName, "runPolicy")
 	submitArgs.TrainingOperatorCRD = compatible
 
+	if submitArgs.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(submitArgs.Name, namespace, submitArgs, tfjob_chart, submitArgs.DryRun, submitArgs.HelmOptions...)
+	}
 	err = workflow.SubmitJob(submitArgs.Name, string(types.TFTrainingJob), namespace, submitArgs, tfjob_chart, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
@@ -43,6 +43,9 @@ func SubmitVolcanoJob(namespace string, submitArgs *types.SubmitVolcanoJobArgs)
 		return err
 	}
 	volcanoChart := util.GetChartsFolder() + "/volcanojob"
+	if submitArgs.DryRun != types.DryRunNone {
+		return workflow.DryRunJob(submitArgs.Name, namespace, submitArgs, volcanoChart, submitArgs.DryRun)
+	}
 	err = workflow.SubmitJob(submitArgs.Name, string(types.VolcanoTrainingJob), namespace, submitArgs, volcanoChart)
 	if err != nil {
 		return err
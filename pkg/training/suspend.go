@@ -0,0 +1,45 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// SuspendTrainingJob suspends (suspend=true) or resumes (suspend=false) the training job,
+// the trainer of the job must implement SuspendableTrainer
+func SuspendTrainingJob(jobName, namespace string, jobType types.TrainingJobType, suspend bool) error {
+	job, err := SearchTrainingJob(jobName, namespace, jobType)
+	if err != nil {
+		return err
+	}
+	trainer, ok := GetAllTrainers()[job.Trainer()].(SuspendableTrainer)
+	if !ok {
+		return fmt.Errorf("the training job %s with type %s does not support suspend and resume", jobName, job.Trainer())
+	}
+	action, done := "resume", "resumed"
+	if suspend {
+		action, done = "suspend", "suspended"
+	}
+	if err := trainer.SuspendTrainingJob(jobName, namespace, suspend); err != nil {
+		return fmt.Errorf("failed to %s the training job %s: %v", action, jobName, err)
+	}
+	log.Infof("The training job %s has been %s successfully", jobName, done)
+	return nil
+}
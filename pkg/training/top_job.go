@@ -173,6 +173,9 @@ func displayWithMetric(jobs []types.TrainingJobInfo, instanceName string, notSto
 		}
 		lines = append(lines, "", "GPUs:")
 		lines = append(lines, fmt.Sprintf("  Allocated/Requested GPUs of Job: %v/%v", jobInfo.AllocatedGPU, jobInfo.RequestGPU))
+		if jobInfo.Accelerator != "" {
+			lines = append(lines, fmt.Sprintf("  Accelerator: %v", jobInfo.Accelerator))
+		}
 		if jobInfo.Status == types.TrainingJobSucceeded || jobInfo.Status == types.TrainingJobFailed {
 			endTime = util.GetFormatTime(jobInfo.CreationTimestamp + duration)
 		}
@@ -200,7 +203,7 @@ func displayWithNoMetric(jobs []types.TrainingJobInfo, notStop bool, format type
 	if allNamespaces {
 		namespace = "NAMESPACE\t"
 	}
-	lines := []string{fmt.Sprintf("%vNAME\tSTATUS\tTRAINER\tAGE\tGPU(Requested)\tGPU(Allocated)\tNODE", namespace)}
+	lines := []string{fmt.Sprintf("%vNAME\tSTATUS\tTRAINER\tAGE\tGPU(Requested)\tGPU(Allocated)\tACCELERATOR\tNODE", namespace)}
 	for _, jobInfo := range jobs {
 		if jobInfo.Status == "RUNNING" {
 			totalRequestedGPUs += jobInfo.RequestGPU
@@ -212,6 +215,10 @@ func displayWithNoMetric(jobs []types.TrainingJobInfo, notStop bool, format type
 				hostIP = instance.NodeIP
 			}
 		}
+		accelerator := jobInfo.Accelerator
+		if accelerator == "" {
+			accelerator = "N/A"
+		}
 		namespace = ""
 		if allNamespaces {
 			namespace = fmt.Sprintf("%v\t", jobInfo.Namespace)
@@ -224,7 +231,7 @@ func displayWithNoMetric(jobs []types.TrainingJobInfo, notStop bool, format type
 			log.Debugf("failed to parse duration: %v", err)
 
 		}
-		lines = append(lines, fmt.Sprintf("%v%v\t%v\t%v\t%v\t%v\t%v\t%v",
+		lines = append(lines, fmt.Sprintf("%v%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v",
 			namespace,
 			jobInfo.Name,
 			jobInfo.Status,
@@ -232,6 +239,7 @@ func displayWithNoMetric(jobs []types.TrainingJobInfo, notStop bool, format type
 			util.ShortHumanDuration(time.Duration(duration)*time.Second),
 			jobInfo.RequestGPU,
 			jobInfo.AllocatedGPU,
+			accelerator,
 			hostIP,
 		))
 	}
@@ -18,12 +18,19 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
+	"sort"
+	"strconv"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
@@ -31,6 +38,9 @@ import (
 	"github.com/kubeflow/arena/pkg/k8saccesser"
 	appwrapperv1beta2 "github.com/kubeflow/arena/pkg/operators/appwrapper-operator/apis/appwrapper/v1beta2"
 	"github.com/kubeflow/arena/pkg/operators/appwrapper-operator/client/clientset/versioned"
+	kueuev1beta1 "github.com/kubeflow/arena/pkg/operators/kueue-operator/apis/kueue/v1beta1"
+	kueueversioned "github.com/kubeflow/arena/pkg/operators/kueue-operator/client/clientset/versioned"
+	"github.com/kubeflow/arena/pkg/queue"
 )
 
 const (
@@ -45,12 +55,14 @@ var chiefPodSuffixPattern = regexp.MustCompile(`[^0-9]-0$`)
 // AppWrapperJob represents an AppWrapper training job
 type AppWrapperJob struct {
 	*BasicJobInfo
-	appwrapper   *appwrapperv1beta2.AppWrapper
-	pods         []*corev1.Pod
-	chiefPod     *corev1.Pod
-	requestedGPU int64
-	allocatedGPU int64
-	trainerType  types.TrainingJobType
+	appwrapper    *appwrapperv1beta2.AppWrapper
+	pods          []*corev1.Pod
+	chiefPod      *corev1.Pod
+	requestedGPU  int64
+	allocatedGPU  int64
+	trainerType   types.TrainingJobType
+	kueueWorkload *types.KueueWorkloadInfo
+	podGroups     []*types.PodGroupInfo
 }
 
 func (aj *AppWrapperJob) Name() string {
@@ -83,6 +95,16 @@ func (aj *AppWrapperJob) GetLabels() map[string]string {
 	return aj.appwrapper.Labels
 }
 
+// KueueWorkload returns the Kueue admission state of the job, nil if the job is not queued by Kueue
+func (aj *AppWrapperJob) KueueWorkload() *types.KueueWorkloadInfo {
+	return aj.kueueWorkload
+}
+
+// PodGroups returns the gang scheduling state of the volcano jobs wrapped by the job, empty if the job is not scheduled by volcano
+func (aj *AppWrapperJob) PodGroups() []*types.PodGroupInfo {
+	return aj.podGroups
+}
+
 // GetStatus returns the status of the Job
 func (aj *AppWrapperJob) GetStatus() string {
 	status := string(types.TrainingJobPending)
@@ -171,7 +193,104 @@ func (aj *AppWrapperJob) GetConditionMessage(conditionType string) string {
 	return ""
 }
 
-// StartTime returns the start time of the job
+// StatusInfo returns the condition timeline, retry history and inner component status of the job
+func (aj *AppWrapperJob) StatusInfo() *types.AppWrapperStatusInfo {
+	status := aj.appwrapper.Status
+	info := &types.AppWrapperStatusInfo{
+		Phase:      string(status.Phase),
+		Retries:    status.Retries,
+		RetryLimit: aj.retryLimit(),
+		Conditions: conditionTimeline(status.Conditions),
+	}
+	if admission := aj.AdmissionTime(); admission != nil {
+		info.AdmissionTime = admission.Unix()
+	}
+	if start := aj.RunStartTime(); start != nil {
+		info.RunStartTime = start.Unix()
+	}
+	if finish := aj.FinishTime(); finish != nil {
+		info.FinishTime = finish.Unix()
+	}
+	// the controller reports the status of the components in the order of the spec
+	components := decodeAppWrapperComponents(aj.appwrapper)
+	for i, component := range components {
+		componentInfo := types.AppWrapperComponentInfo{
+			Name:           component.name,
+			Kind:           component.gvk.Kind,
+			APIVersion:     component.gvk.GroupVersion().String(),
+			CleanPodPolicy: component.cleanPodPolicy(),
+			ExpectedPods:   component.expectedPods(),
+			Replicas:       aj.replicaStatus(component),
+		}
+		if i < len(status.ComponentStatus) {
+			componentInfo.Conditions = conditionTimeline(status.ComponentStatus[i].Conditions)
+		}
+		info.Components = append(info.Components, componentInfo)
+		if info.RankTable == nil {
+			info.RankTable = buildAscendRankTable(component, aj.pods)
+		}
+	}
+	for i := len(components); i < len(status.ComponentStatus); i++ {
+		component := status.ComponentStatus[i]
+		info.Components = append(info.Components, types.AppWrapperComponentInfo{
+			Name:       component.Name,
+			Kind:       component.Kind,
+			APIVersion: component.APIVersion,
+			Conditions: conditionTimeline(component.Conditions),
+		})
+	}
+	return info
+}
+
+// replicaStatus counts the running pods of each role declared in the spec of the component
+func (aj *AppWrapperJob) replicaStatus(component *appWrapperComponent) []types.AppWrapperReplicaInfo {
+	replicas := []types.AppWrapperReplicaInfo{}
+	for _, role := range component.roles() {
+		replica := types.AppWrapperReplicaInfo{Role: role.name, Expected: role.replicas}
+		for _, pod := range aj.pods {
+			if podRole, _, ok := component.roleOfPod(pod); ok && podRole == role.name && pod.Status.Phase == corev1.PodRunning {
+				replica.Running++
+			}
+		}
+		replicas = append(replicas, replica)
+	}
+	return replicas
+}
+
+// retryLimit returns the retry limit annotated on the AppWrapper, or the controller default
+func (aj *AppWrapperJob) retryLimit() int32 {
+	value, ok := aj.appwrapper.Annotations[appwrapperv1beta2.RetryLimitAnnotation]
+	if !ok {
+		return appwrapperv1beta2.DefaultRetryLimit
+	}
+	limit, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		log.Debugf("invalid retry limit %q of AppWrapper %s: %v", value, aj.appwrapper.Name, err)
+		return appwrapperv1beta2.DefaultRetryLimit
+	}
+	return int32(limit)
+}
+
+// conditionTimeline orders the conditions by their last transition time
+func conditionTimeline(conditions []metav1.Condition) []types.TrainingJobCondition {
+	timeline := []types.TrainingJobCondition{}
+	for _, cond := range conditions {
+		timeline = append(timeline, types.TrainingJobCondition{
+			Type:               cond.Type,
+			Status:             string(cond.Status),
+			Reason:             cond.Reason,
+			Message:            cond.Message,
+			LastTransitionTime: cond.LastTransitionTime.Unix(),
+		})
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].LastTransitionTime < timeline[j].LastTransitionTime
+	})
+	return timeline
+}
+
+// StartTime returns the creation time of the job like the other trainers,
+// use AdmissionTime and RunStartTime for the queue and run phases
 func (aj *AppWrapperJob) StartTime() *metav1.Time {
 	return &aj.appwrapper.CreationTimestamp
 }
@@ -184,27 +303,102 @@ func (aj *AppWrapperJob) Age() time.Duration {
 	return metav1.Now().Sub(aj.appwrapper.CreationTimestamp.Time)
 }
 
-// Duration returns the training duration of the job
+// Duration returns the training duration of the job, from the run start to the finish time
+// (or now if the job is still running). The time queued in Kueue is not counted, see LastAdmittedAfter
 func (aj *AppWrapperJob) Duration() time.Duration {
+	start := aj.RunStartTime()
+	if start == nil {
+		return 0
+	}
+	return nonNegativeDuration(aj.endTime().Sub(start.Time))
+}
+
+// LastAdmittedAfter returns the time from the creation of the job until quota was last reserved
+// (or until now/the finish time if it is still not admitted). It is not the total queue time:
+// the conditions only record the latest admission, so for a job which was evicted and readmitted
+// it also includes the time it ran before the eviction
+func (aj *AppWrapperJob) LastAdmittedAfter() time.Duration {
 	if aj.appwrapper.CreationTimestamp.IsZero() {
 		return 0
 	}
+	end := aj.endTime()
+	if admission := aj.AdmissionTime(); admission != nil {
+		end = admission.Time
+	}
+	return nonNegativeDuration(end.Sub(aj.appwrapper.CreationTimestamp.Time))
+}
 
-	// If job is completed or failed, calculate duration from conditions
-	for _, cond := range aj.appwrapper.Status.Conditions {
-		if cond.Type == appwrapperv1beta2.AppWrapperConditionPodsReady && cond.Status == metav1.ConditionTrue {
-			if !cond.LastTransitionTime.IsZero() {
-				return cond.LastTransitionTime.Sub(aj.appwrapper.CreationTimestamp.Time)
-			}
+// AdmissionTime returns the time quota was last reserved for the job, nil if it is queuing
+func (aj *AppWrapperJob) AdmissionTime() *metav1.Time {
+	return aj.conditionTrueTime(appwrapperv1beta2.AppWrapperConditionQuotaReserved)
+}
+
+// RunStartTime returns the time all pods of the job became ready, nil if the job never ran
+func (aj *AppWrapperJob) RunStartTime() *metav1.Time {
+	if start := aj.conditionTrueTime(appwrapperv1beta2.AppWrapperConditionPodsReady); start != nil {
+		return start
+	}
+	// PodsReady turns False once the job finishes, fall back to the earliest pod start
+	var start *metav1.Time
+	for _, pod := range aj.pods {
+		if pod.Status.StartTime == nil || pod.Status.StartTime.IsZero() {
+			continue
+		}
+		if start == nil || pod.Status.StartTime.Before(start) {
+			start = pod.Status.StartTime
+		}
+	}
+	return start
+}
+
+// FinishTime returns the time the job succeeded or failed, nil if it is not finished
+func (aj *AppWrapperJob) FinishTime() *metav1.Time {
+	phase := aj.appwrapper.Status.Phase
+	if phase != appwrapperv1beta2.AppWrapperSucceeded && phase != appwrapperv1beta2.AppWrapperFailed {
+		return nil
+	}
+	// the controller turns the conditions False with the phase as reason when the job finishes,
+	// the conditions changed later (e.g. the resources deleted after successTTL) are not counted
+	var finish *metav1.Time
+	for i := range aj.appwrapper.Status.Conditions {
+		cond := &aj.appwrapper.Status.Conditions[i]
+		if cond.Reason != string(phase) || cond.LastTransitionTime.IsZero() {
+			continue
+		}
+		if finish == nil || cond.LastTransitionTime.Before(finish) {
+			finish = &cond.LastTransitionTime
 		}
 	}
+	if finish == nil && phase == appwrapperv1beta2.AppWrapperFailed {
+		// the failure is detected by the Unhealthy condition before the resources are deleted
+		finish = aj.conditionTrueTime(appwrapperv1beta2.AppWrapperConditionUnhealthy)
+	}
+	return finish
+}
 
-	// If job is still running, return time since creation
-	if aj.GetStatus() == string(types.TrainingJobRunning) || aj.GetStatus() == string(types.TrainingJobSucceeded) {
-		return metav1.Now().Sub(aj.appwrapper.CreationTimestamp.Time)
+// endTime returns the finish time of the job, or now if it is not finished
+func (aj *AppWrapperJob) endTime() time.Time {
+	if finish := aj.FinishTime(); finish != nil {
+		return finish.Time
 	}
+	return metav1.Now().Time
+}
 
-	return 0
+// conditionTrueTime returns the last transition time of the condition if its status is True
+func (aj *AppWrapperJob) conditionTrueTime(conditionType string) *metav1.Time {
+	for i, cond := range aj.appwrapper.Status.Conditions {
+		if cond.Type == conditionType && cond.Status == metav1.ConditionTrue && !cond.LastTransitionTime.IsZero() {
+			return &aj.appwrapper.Status.Conditions[i].LastTransitionTime
+		}
+	}
+	return nil
+}
+
+func nonNegativeDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
 }
 
 // GetJobDashboards returns dashboard URLs for the job
@@ -297,7 +491,9 @@ func (aj *AppWrapperJob) GetPriorityClass() string {
 // AppWrapperJobTrainer is the trainer for AppWrapper jobs
 type AppWrapperJobTrainer struct {
 	client           *kubernetes.Clientset
-	appwrapperClient *versioned.Clientset
+	appwrapperClient versioned.Interface
+	kueueClient      kueueversioned.Interface
+	dynamicClient    dynamic.Interface
 	trainerType      types.TrainingJobType
 	enabled          bool
 }
@@ -318,12 +514,35 @@ func NewAppWrapperJobTrainer() Trainer {
 		log.Debugf("AppWrapperJobTrainer is disabled, reason: %v", err)
 	}
 
+	// Kueue is optional, jobs are shown without admission state if it is not installed
+	kueueClient, err := queue.GetKueueClient()
+	if err != nil {
+		log.Debugf("AppWrapperJobTrainer runs without kueue, reason: %v", err)
+	}
+
+	// the PodGroups of the wrapped volcano jobs are read by the dynamic client
+	trainer := NewAppWrapperJobTrainerWithClient(config.GetArenaConfiger().GetClientSet(), appwrapperClient, kueueClient, enable)
+	// the client is only assigned if it is created, a nil *DynamicClient is not a nil dynamic.Interface
+	if dynamicClient, err := dynamic.NewForConfig(config.GetArenaConfiger().GetRestConfig()); err != nil {
+		log.Debugf("AppWrapperJobTrainer runs without PodGroups, reason: %v", err)
+	} else {
+		trainer.dynamicClient = dynamicClient
+	}
+
 	log.Debugf("Succeed to init AppWrapperJobTrainer")
+	return trainer
+}
+
+// NewAppWrapperJobTrainerWithClient creates an AppWrapperJobTrainer with the given clients,
+// so that callers (and tests) can inject fake AppWrapper and Kueue clientsets.
+// kueueClient may be nil if Kueue is not installed
+func NewAppWrapperJobTrainerWithClient(client *kubernetes.Clientset, appwrapperClient versioned.Interface, kueueClient kueueversioned.Interface, enabled bool) *AppWrapperJobTrainer {
 	return &AppWrapperJobTrainer{
 		appwrapperClient: appwrapperClient,
-		client:           config.GetArenaConfiger().GetClientSet(),
+		kueueClient:      kueueClient,
+		client:           client,
 		trainerType:      types.AppWrapperJob,
-		enabled:          enable,
+		enabled:          enabled,
 	}
 }
 
@@ -337,6 +556,20 @@ func (at *AppWrapperJobTrainer) Type() types.TrainingJobType {
 	return at.trainerType
 }
 
+// JobResource returns the resource of the jobs watched by wait
+func (at *AppWrapperJobTrainer) JobResource() schema.GroupVersionResource {
+	return appwrapperv1beta2.SchemeGroupVersion.WithResource("appwrappers")
+}
+
+// StatusOfObject returns the display status of the watched AppWrapper, it only depends on the AppWrapper itself
+func (at *AppWrapperJobTrainer) StatusOfObject(obj *unstructured.Unstructured) (types.TrainingJobStatus, bool, error) {
+	aw := &appwrapperv1beta2.AppWrapper{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, aw); err != nil {
+		return "", false, err
+	}
+	return types.TrainingJobStatus((&AppWrapperJob{appwrapper: aw}).GetDisplayStatus()), true, nil
+}
+
 // IsSupported checks if the job is supported
 func (at *AppWrapperJobTrainer) IsSupported(name, ns string) bool {
 	if !at.enabled {
@@ -348,10 +581,10 @@ func (at *AppWrapperJobTrainer) IsSupported(name, ns string) bool {
 
 // GetTrainingJob retrieves a training job by name and namespace
 func (at *AppWrapperJobTrainer) GetTrainingJob(name, namespace string) (TrainingJob, error) {
-	appwrapper, err := at.appwrapperClient.WorkloadV1beta2().AppWrappers(namespace).Get(name, metav1.GetOptions{})
+	appwrapper, err := at.appwrapperClient.WorkloadV1beta2().AppWrappers(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
 		// Convert "not found" error to types.ErrTrainingJobNotFound
-		if strings.Contains(err.Error(), fmt.Sprintf(`"%v" not found`, name)) {
+		if k8serrors.IsNotFound(err) {
 			return nil, types.ErrTrainingJobNotFound
 		}
 		return nil, err
@@ -368,20 +601,83 @@ func (at *AppWrapperJobTrainer) GetTrainingJob(name, namespace string) (Training
 	}
 
 	pods, chiefPod := getPodsOfAppWrapperJob(at, appwrapper, allPods)
+	resources := at.resources(name, namespace, pods)
+	workloads, clusterQueues := at.listWorkloads(namespace)
+	workload := queue.WorkloadOfJob(appwrapper.UID, workloads)
+	if workload != nil {
+		resources = append(resources, Resource{
+			Name:         workload.Name,
+			Uid:          string(workload.UID),
+			ResourceType: ResourceTypeWorkload,
+		})
+	}
+	podGroups := findPodGroups(at.dynamicClient, namespace, pods, func() [][]string {
+		return podGroupNamesOfWrappedVolcanoJobs(at.dynamicClient, namespace, fmt.Sprintf("%s=%s", appWrapperLabelName, name))
+	})
+	podGroupInfos := []*types.PodGroupInfo{}
+	for _, podGroup := range podGroups {
+		resources = append(resources, podGroup.resource())
+		podGroupInfos = append(podGroupInfos, podGroup.info())
+	}
 
 	return &AppWrapperJob{
 		BasicJobInfo: &BasicJobInfo{
-			resources: at.resources(name, namespace, pods),
+			resources: resources,
 			name:      name,
 		},
-		appwrapper:  appwrapper,
-		chiefPod:    chiefPod,
-		pods:        pods,
-		trainerType: at.Type(),
+		appwrapper:    appwrapper,
+		chiefPod:      chiefPod,
+		pods:          pods,
+		trainerType:   at.Type(),
+		kueueWorkload: queue.BuildWorkloadInfo(workload, workloads, clusterQueues),
+		podGroups:     podGroupInfos,
 	}, nil
 }
 
+// listWorkloads lists the Kueue Workloads queued with the jobs of the namespace and the ClusterQueues
+// of the LocalQueues, it returns nil if Kueue is not installed or the Workloads can not be listed
+func (at *AppWrapperJobTrainer) listWorkloads(namespace string) ([]kueuev1beta1.Workload, map[string]string) {
+	if at.kueueClient == nil {
+		return nil, nil
+	}
+	workloads, clusterQueues, err := queue.ListQueuedWorkloads(at.kueueClient, namespace)
+	if err != nil {
+		log.Debugf("failed to list kueue workloads of namespace %v: %v", namespace, err)
+		return nil, nil
+	}
+	return workloads, clusterQueues
+}
+
+// SuspendTrainingJob suspends or resumes the AppWrapper by patching spec.suspend
+func (at *AppWrapperJobTrainer) SuspendTrainingJob(name, namespace string, suspend bool) error {
+	patch := fmt.Sprintf(`{"spec":{"suspend":%v}}`, suspend)
+	_, err := at.appwrapperClient.WorkloadV1beta2().AppWrappers(namespace).Patch(context.TODO(), name, k8stypes.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return types.ErrTrainingJobNotFound
+		}
+		return err
+	}
+	return nil
+}
+
 func (at *AppWrapperJobTrainer) isChiefPod(appwrapper *appwrapperv1beta2.AppWrapper, item *corev1.Pod) bool {
+	return isChiefPodOfComponents(decodeAppWrapperComponents(appwrapper), item)
+}
+
+// isChiefPodOfComponents checks whether the pod is the chief of the first workload component,
+// it falls back to pod labels and names if the pod has no role in the decoded components
+func isChiefPodOfComponents(components []*appWrapperComponent, item *corev1.Pod) bool {
+	first := true
+	for _, component := range components {
+		if len(component.roles()) == 0 {
+			continue
+		}
+		if role, index, ok := component.roleOfPod(item); ok {
+			return first && component.isChiefRole(role, index)
+		}
+		first = false
+	}
 	// For PyTorch jobs wrapped in AppWrapper, check for master label
 	if val, ok := item.Labels["pytorch-replica-type"]; ok && val == "master" {
 		return true
@@ -436,7 +732,7 @@ func (at *AppWrapperJobTrainer) ListTrainingJobs(namespace string, allNamespace
 	jobLabels := GetTrainingJobLabels(at.Type())
 
 	// List all AppWrappers
-	appwrappers, err := at.appwrapperClient.WorkloadV1beta2().AppWrappers(namespace).List(metav1.ListOptions{
+	appwrappers, err := at.appwrapperClient.WorkloadV1beta2().AppWrappers(namespace).List(context.TODO(), metav1.ListOptions{
 		LabelSelector: jobLabels,
 	})
 	if err != nil {
@@ -448,18 +744,26 @@ func (at *AppWrapperJobTrainer) ListTrainingJobs(namespace string, allNamespace
 		return nil, err
 	}
 
+	var workloads []kueuev1beta1.Workload
+	var clusterQueues map[string]string
+	if len(appwrappers.Items) > 0 {
+		workloads, clusterQueues = at.listWorkloads(namespace)
+	}
+
 	for _, aw := range appwrappers.Items {
 		awCopy := aw
 		filterPods, chiefPod := getPodsOfAppWrapperJob(at, &awCopy, pods)
+		workload := queue.WorkloadOfJob(awCopy.UID, workloads)
 		trainingJobs = append(trainingJobs, &AppWrapperJob{
 			BasicJobInfo: &BasicJobInfo{
 				resources: podResources(filterPods),
 				name:      aw.Name,
 			},
-			appwrapper:  &awCopy,
-			chiefPod:    chiefPod,
-			pods:        filterPods,
-			trainerType: at.Type(),
+			appwrapper:    &awCopy,
+			chiefPod:      chiefPod,
+			pods:          filterPods,
+			trainerType:   at.Type(),
+			kueueWorkload: queue.BuildWorkloadInfo(workload, workloads, clusterQueues),
 		})
 	}
 
@@ -468,7 +772,8 @@ func (at *AppWrapperJobTrainer) ListTrainingJobs(namespace string, allNamespace
 
 // getPodsOfAppWrapperJob filters pods belonging to an AppWrapper job
 func getPodsOfAppWrapperJob(at *AppWrapperJobTrainer, appwrapper *appwrapperv1beta2.AppWrapper, podList []*corev1.Pod) ([]*corev1.Pod, *corev1.Pod) {
+	components := decodeAppWrapperComponents(appwrapper)
 	return getPodsOfTrainingJob(appwrapper.Name, appwrapper.Namespace, podList, at.isAppWrapperPod, func(pod *corev1.Pod) bool {
-		return at.isChiefPod(appwrapper, pod)
+		return isChiefPodOfComponents(components, pod)
 	})
 }
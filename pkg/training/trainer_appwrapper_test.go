@@ -0,0 +1,881 @@
+//
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package training
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	appwrapperv1beta2 "github.com/kubeflow/arena/pkg/operators/appwrapper-operator/apis/appwrapper/v1beta2"
+	"github.com/kubeflow/arena/pkg/operators/appwrapper-operator/client/clientset/versioned/fake"
+	etv1alpha1 "github.com/kubeflow/arena/pkg/operators/et-operator/api/v1alpha1"
+	kueuev1beta1 "github.com/kubeflow/arena/pkg/operators/kueue-operator/apis/kueue/v1beta1"
+	kueuefake "github.com/kubeflow/arena/pkg/operators/kueue-operator/client/clientset/versioned/fake"
+	mpiv1alpha1 "github.com/kubeflow/arena/pkg/operators/mpi-operator/apis/kubeflow/v1alpha1"
+	pytorchv1 "github.com/kubeflow/arena/pkg/operators/pytorch-operator/apis/pytorch/v1"
+	commonv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/common/v1"
+	tfv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/tensorflow/v1"
+	volcanov1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+	"github.com/kubeflow/arena/pkg/queue"
+	"github.com/kubeflow/arena/pkg/ranktable"
+)
+
+func newTestAppWrapper(name string, phase appwrapperv1beta2.AppWrapperPhase, conditions ...metav1.Condition) *appwrapperv1beta2.AppWrapper {
+	return &appwrapperv1beta2.AppWrapper{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":     string(types.AppWrapperJob),
+				"release": name,
+			},
+		},
+		Status: appwrapperv1beta2.AppWrapperStatus{
+			Phase:      phase,
+			Conditions: conditions,
+		},
+	}
+}
+
+func TestAppWrapperJobGetStatus(t *testing.T) {
+	unhealthy := metav1.Condition{
+		Type:   appwrapperv1beta2.AppWrapperConditionUnhealthy,
+		Status: metav1.ConditionTrue,
+	}
+	healthy := metav1.Condition{
+		Type:   appwrapperv1beta2.AppWrapperConditionUnhealthy,
+		Status: metav1.ConditionFalse,
+	}
+
+	testcases := []struct {
+		name            string
+		appwrapper      *appwrapperv1beta2.AppWrapper
+		expected        string
+		expectedDisplay string
+	}{
+		{
+			name:            "unnamed",
+			appwrapper:      &appwrapperv1beta2.AppWrapper{},
+			expected:        "PENDING",
+			expectedDisplay: "PENDING",
+		},
+		{
+			name:            "empty",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperEmpty),
+			expected:        "QUEUING",
+			expectedDisplay: "SUSPENDED",
+		},
+		{
+			name:            "suspended",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperSuspended),
+			expected:        "QUEUING",
+			expectedDisplay: "SUSPENDED",
+		},
+		{
+			name:            "resuming",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperResuming),
+			expected:        "RUNNING",
+			expectedDisplay: "SUSPENDED",
+		},
+		{
+			name:            "running",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning),
+			expected:        "RUNNING",
+			expectedDisplay: "RUNNING",
+		},
+		{
+			name:            "running and healthy",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning, healthy),
+			expected:        "RUNNING",
+			expectedDisplay: "RUNNING",
+		},
+		{
+			name:            "running but unhealthy",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning, unhealthy),
+			expected:        "FAILED",
+			expectedDisplay: "FAILED",
+		},
+		{
+			name:            "resetting",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperResetting),
+			expected:        "PENDING",
+			expectedDisplay: "SUSPENDED",
+		},
+		{
+			name:            "suspending",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperSuspending),
+			expected:        "PENDING",
+			expectedDisplay: "SUSPENDED",
+		},
+		{
+			name:            "succeeded",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperSucceeded),
+			expected:        "SUCCEEDED",
+			expectedDisplay: "SUCCEEDED",
+		},
+		{
+			name:            "failed",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperFailed),
+			expected:        "FAILED",
+			expectedDisplay: "FAILED",
+		},
+		{
+			name:            "terminating",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperTerminating),
+			expected:        "PENDING",
+			expectedDisplay: "FAILED",
+		},
+		{
+			name:            "unknown phase",
+			appwrapper:      newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperPhase("Unknown")),
+			expected:        "PENDING",
+			expectedDisplay: "PENDING",
+		},
+	}
+
+	for _, tc := range testcases {
+		job := &AppWrapperJob{appwrapper: tc.appwrapper}
+		if actual := job.GetStatus(); actual != tc.expected {
+			t.Errorf("%s: expected status %s, got %s", tc.name, tc.expected, actual)
+		}
+		if actual := job.GetDisplayStatus(); actual != tc.expectedDisplay {
+			t.Errorf("%s: expected display status %s, got %s", tc.name, tc.expectedDisplay, actual)
+		}
+	}
+}
+
+func TestAppWrapperIsChiefPod(t *testing.T) {
+	at := NewAppWrapperJobTrainerWithClient(nil, fake.NewSimpleClientset(), nil, true)
+	appwrapper := newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning)
+
+	testcases := []struct {
+		name     string
+		pod      *corev1.Pod
+		expected bool
+	}{
+		{
+			name: "pytorch master",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:   "aw-master-x",
+				Labels: map[string]string{"pytorch-replica-type": "master"},
+			}},
+			expected: true,
+		},
+		{
+			name: "training-operator master",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:   "aw-master-x",
+				Labels: map[string]string{"training.kubeflow.org/replica-type": "master"},
+			}},
+			expected: true,
+		},
+		{
+			name: "training-operator worker",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:   "aw-worker-x",
+				Labels: map[string]string{"training.kubeflow.org/replica-type": "worker"},
+			}},
+			expected: false,
+		},
+		{
+			name: "volcano driver",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:   "aw-driver-x",
+				Labels: map[string]string{"volcano-role": "driver"},
+			}},
+			expected: true,
+		},
+		{
+			name: "volcano first task",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:        "aw-task-x",
+				Annotations: map[string]string{"volcano.sh/task-index": "0"},
+			}},
+			expected: true,
+		},
+		{
+			name: "volcano second task",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:        "aw-task-1",
+				Annotations: map[string]string{"volcano.sh/task-index": "1"},
+			}},
+			expected: false,
+		},
+		{
+			name:     "name suffix -0",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "aw-worker-0"}},
+			expected: true,
+		},
+		{
+			name:     "name suffix -10",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "aw-worker-10"}},
+			expected: false,
+		},
+		{
+			name:     "name suffix -1",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "aw-worker-1"}},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		if actual := at.isChiefPod(appwrapper, tc.pod); actual != tc.expected {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, actual)
+		}
+	}
+}
+
+func TestGetPodsOfAppWrapperJob(t *testing.T) {
+	at := NewAppWrapperJobTrainerWithClient(nil, fake.NewSimpleClientset(), nil, true)
+	appwrapper := newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning)
+	now := metav1.Now()
+	earlier := metav1.NewTime(now.Add(-time.Minute))
+
+	newPod := func(name, namespace string, labels map[string]string, phase corev1.PodPhase, created metav1.Time) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				Labels:            labels,
+				CreationTimestamp: created,
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	awLabels := map[string]string{appWrapperLabelName: "aw"}
+
+	pods := []*corev1.Pod{
+		newPod("aw-worker-0", "default", awLabels, corev1.PodRunning, earlier),
+		newPod("aw-worker-1", "default", awLabels, corev1.PodRunning, earlier),
+		newPod("aw-launcher-0", "default", map[string]string{"release": "aw", "app": string(types.AppWrapperJob)}, corev1.PodPending, now),
+		newPod("aw-worker-0", "other", awLabels, corev1.PodRunning, now),
+		newPod("other-worker-0", "default", map[string]string{appWrapperLabelName: "other"}, corev1.PodRunning, now),
+		newPod("aw-tfjob-0", "default", map[string]string{"release": "aw", "app": string(types.TFTrainingJob)}, corev1.PodRunning, now),
+	}
+
+	filtered, chief := getPodsOfAppWrapperJob(at, appwrapper, pods)
+	expected := []string{"aw-worker-0", "aw-worker-1", "aw-launcher-0"}
+	if len(filtered) != len(expected) {
+		t.Fatalf("expected %d pods, got %d", len(expected), len(filtered))
+	}
+	for i, pod := range filtered {
+		if pod.Name != expected[i] || pod.Namespace != "default" {
+			t.Errorf("expected pod default/%s, got %s/%s", expected[i], pod.Namespace, pod.Name)
+		}
+	}
+	// running chief pods take precedence over pending ones
+	if chief.Name != "aw-worker-0" {
+		t.Errorf("expected chief pod aw-worker-0, got %s", chief.Name)
+	}
+
+	_, chief = getPodsOfAppWrapperJob(at, appwrapper, pods[1:3])
+	if chief.Name != "aw-launcher-0" {
+		t.Errorf("expected pending chief pod aw-launcher-0, got %s", chief.Name)
+	}
+
+	_, chief = getPodsOfAppWrapperJob(at, appwrapper, pods[1:2])
+	if chief == nil || chief.Name != "" {
+		t.Errorf("expected an empty chief pod, got %v", chief)
+	}
+}
+
+func TestAppWrapperGetTrainingJobNotFound(t *testing.T) {
+	client := fake.NewSimpleClientset(newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning))
+	at := NewAppWrapperJobTrainerWithClient(nil, client, nil, true)
+
+	if _, err := at.GetTrainingJob("missing", "default"); err != types.ErrTrainingJobNotFound {
+		t.Errorf("expected %v, got %v", types.ErrTrainingJobNotFound, err)
+	}
+	if at.IsSupported("missing", "default") {
+		t.Errorf("expected missing appwrapper job to be unsupported")
+	}
+	if _, err := at.GetTrainingJob("aw", "other"); err != types.ErrTrainingJobNotFound {
+		t.Errorf("expected %v in other namespace, got %v", types.ErrTrainingJobNotFound, err)
+	}
+}
+
+func TestAppWrapperSuspendTrainingJob(t *testing.T) {
+	client := fake.NewSimpleClientset(newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning))
+	at := NewAppWrapperJobTrainerWithClient(nil, client, nil, true)
+
+	for _, suspend := range []bool{true, false} {
+		if err := at.SuspendTrainingJob("aw", "default", suspend); err != nil {
+			t.Fatalf("failed to set suspend=%v: %v", suspend, err)
+		}
+		aw, err := client.WorkloadV1beta2().AppWrappers("default").Get(context.TODO(), "aw", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("failed to get appwrapper: %v", err)
+		}
+		if aw.Spec.Suspend != suspend {
+			t.Errorf("expected spec.suspend=%v, got %v", suspend, aw.Spec.Suspend)
+		}
+	}
+
+	if err := at.SuspendTrainingJob("missing", "default", true); err != types.ErrTrainingJobNotFound {
+		t.Errorf("expected %v, got %v", types.ErrTrainingJobNotFound, err)
+	}
+}
+
+func TestAppWrapperAcceleratorAccounting(t *testing.T) {
+	newPod := func(resourceName string, count int64) *corev1.Pod {
+		return &corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name: "main-con",
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceName(resourceName): *resource.NewQuantity(count, resource.DecimalSI),
+						},
+					},
+				}},
+			},
+		}
+	}
+	pods := []*corev1.Pod{newPod("huawei.com/Ascend910", 8), newPod("huawei.com/Ascend910", 8)}
+
+	if count := gpuInPod(*pods[0]); count != 0 {
+		t.Errorf("expected unconfigured accelerator not to be counted, got %d", count)
+	}
+	if kind := acceleratorOfJob(pods); kind != "" {
+		t.Errorf("expected unconfigured accelerator not to be the accelerator of the job, got %q", kind)
+	}
+
+	resourceNames := []string{NVIDIAGPUResourceName, "amd.com/gpu", "huawei.com/Ascend910"}
+	total := 0
+	for _, pod := range pods {
+		kind, count := utils.AcceleratorInPod(pod, resourceNames)
+		if kind != "huawei.com/Ascend910" {
+			t.Errorf("expected accelerator huawei.com/Ascend910, got %q", kind)
+		}
+		total += count
+	}
+	if total != 16 {
+		t.Errorf("expected 16 accelerators, got %d", total)
+	}
+	if count := gpuInPod(*newPod(NVIDIAGPUResourceName, 2)); count != 2 {
+		t.Errorf("expected nvidia gpus to be always counted, got %d", count)
+	}
+}
+
+func TestAppWrapperKueueWorkload(t *testing.T) {
+	now := time.Now()
+	newWorkload := func(namespace, queueName, name, jobUID string, created time.Time, conditions ...metav1.Condition) *kueuev1beta1.Workload {
+		return &kueuev1beta1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels:            map[string]string{kueuev1beta1.JobUIDLabel: jobUID},
+			},
+			Spec:   kueuev1beta1.WorkloadSpec{QueueName: queueName},
+			Status: kueuev1beta1.WorkloadStatus{Conditions: conditions},
+		}
+	}
+	newLocalQueue := func(namespace, name, clusterQueue string) *kueuev1beta1.LocalQueue {
+		return &kueuev1beta1.LocalQueue{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       kueuev1beta1.LocalQueueSpec{ClusterQueue: kueuev1beta1.ClusterQueueReference(clusterQueue)},
+		}
+	}
+	pending := metav1.Condition{
+		Type:    kueuev1beta1.WorkloadQuotaReserved,
+		Status:  metav1.ConditionFalse,
+		Reason:  "Pending",
+		Message: "couldn't assign flavors to pod set main",
+	}
+	admitted := []metav1.Condition{
+		{Type: kueuev1beta1.WorkloadQuotaReserved, Status: metav1.ConditionTrue},
+		{Type: kueuev1beta1.WorkloadAdmitted, Status: metav1.ConditionTrue},
+	}
+	// created first but evicted after the creation of the others, it is requeued at its eviction time
+	evicted := metav1.Condition{Type: kueuev1beta1.WorkloadEvicted, Status: metav1.ConditionTrue, LastTransitionTime: metav1.NewTime(now.Add(-30 * time.Minute))}
+	running := newWorkload("default", "team-a", "appwrapper-running", "uid-running", now.Add(-3*time.Hour), admitted...)
+	running.Status.Admission = &kueuev1beta1.Admission{ClusterQueue: "cluster-queue"}
+	kueueClient := kueuefake.NewSimpleClientset(
+		newLocalQueue("default", "team-a", "cluster-queue"),
+		newLocalQueue("other", "team-b", "cluster-queue"),
+		newLocalQueue("other", "team-c", "other-cluster-queue"),
+		running,
+		newWorkload("default", "team-a", "appwrapper-first", "uid-first", now.Add(-2*time.Hour), pending),
+		newWorkload("default", "team-a", "appwrapper-second", "uid-second", now.Add(-time.Hour), pending),
+		// queued in the same ClusterQueue from another namespace
+		newWorkload("other", "team-b", "appwrapper-other", "uid-other", now.Add(-90*time.Minute), pending),
+		// queued in another ClusterQueue
+		newWorkload("other", "team-c", "appwrapper-elsewhere", "uid-elsewhere", now.Add(-4*time.Hour), pending),
+		newWorkload("other", "team-b", "appwrapper-evicted", "uid-evicted", now.Add(-5*time.Hour), pending, evicted),
+	)
+	at := NewAppWrapperJobTrainerWithClient(nil, fake.NewSimpleClientset(), kueueClient, true)
+	workloads, clusterQueues := at.listWorkloads("default")
+	if clusterQueues["other/team-b"] != "cluster-queue" {
+		t.Errorf("expected local queue other/team-b to map to cluster-queue, got %v", clusterQueues)
+	}
+
+	workload := queue.WorkloadOfJob("uid-second", workloads)
+	if workload == nil || workload.Name != "appwrapper-second" {
+		t.Fatalf("expected workload appwrapper-second, got %v", workload)
+	}
+	info := queue.BuildWorkloadInfo(workload, workloads, clusterQueues)
+	if info.Admitted || info.QuotaReserved {
+		t.Errorf("expected pending workload, got %+v", info)
+	}
+	if info.PendingReason != "Pending" || info.PendingMessage != pending.Message {
+		t.Errorf("unexpected pending reason %q: %q", info.PendingReason, info.PendingMessage)
+	}
+	if info.QueuePosition != 3 {
+		t.Errorf("expected queue position 3, got %d", info.QueuePosition)
+	}
+	workload = queue.WorkloadOfJob("uid-evicted", workloads)
+	if info := queue.BuildWorkloadInfo(workload, workloads, clusterQueues); info.QueuePosition != 4 {
+		t.Errorf("expected the evicted workload to be requeued at position 4, got %d", info.QueuePosition)
+	}
+	workload = queue.WorkloadOfJob("uid-elsewhere", workloads)
+	if info := queue.BuildWorkloadInfo(workload, workloads, clusterQueues); info.QueuePosition != 1 {
+		t.Errorf("expected the workload of other-cluster-queue at position 1, got %d", info.QueuePosition)
+	}
+	// the LocalQueues are ranked on their own without their ClusterQueues
+	workload = queue.WorkloadOfJob("uid-second", workloads)
+	if info := queue.BuildWorkloadInfo(workload, workloads, nil); info.QueuePosition != 2 {
+		t.Errorf("expected queue position 2 in the local queue, got %d", info.QueuePosition)
+	}
+
+	workload = queue.WorkloadOfJob("uid-running", workloads)
+	info = queue.BuildWorkloadInfo(workload, workloads, clusterQueues)
+	if !info.Admitted || info.ClusterQueue != "cluster-queue" || info.QueuePosition != 0 {
+		t.Errorf("expected admitted workload in cluster-queue, got %+v", info)
+	}
+
+	if workload := queue.WorkloadOfJob("uid-unknown", workloads); workload != nil {
+		t.Errorf("expected no workload, got %v", workload.Name)
+	}
+	if workloads, _ := NewAppWrapperJobTrainerWithClient(nil, fake.NewSimpleClientset(), nil, true).listWorkloads("default"); workloads != nil {
+		t.Errorf("expected no workloads without kueue, got %d", len(workloads))
+	}
+}
+
+func TestAppWrapperStatusInfo(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	aw := newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperResetting,
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionUnhealthy, Status: metav1.ConditionTrue, Reason: "FailedPods", LastTransitionTime: metav1.NewTime(created.Add(30 * time.Minute))},
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionQuotaReserved, Status: metav1.ConditionTrue, LastTransitionTime: metav1.NewTime(created)},
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionPodsReady, Status: metav1.ConditionFalse, LastTransitionTime: metav1.NewTime(created.Add(20 * time.Minute))},
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionResourcesDeployed, Status: metav1.ConditionTrue, LastTransitionTime: metav1.NewTime(created.Add(time.Minute))},
+	)
+	aw.Status.Retries = 2
+	aw.Status.ComponentStatus = []appwrapperv1beta2.AppWrapperComponentStatus{{
+		Name:       "aw",
+		Kind:       "Job",
+		APIVersion: "batch.volcano.sh/v1alpha1",
+		Conditions: []metav1.Condition{{Type: "ResourcesDeployed", Status: metav1.ConditionTrue}},
+	}}
+	job := &AppWrapperJob{appwrapper: aw}
+
+	info := job.StatusInfo()
+	if info.Phase != "Resetting" || info.Retries != 2 || info.RetryLimit != appwrapperv1beta2.DefaultRetryLimit {
+		t.Errorf("unexpected status info: %+v", info)
+	}
+	expected := []string{
+		appwrapperv1beta2.AppWrapperConditionQuotaReserved,
+		appwrapperv1beta2.AppWrapperConditionResourcesDeployed,
+		appwrapperv1beta2.AppWrapperConditionPodsReady,
+		appwrapperv1beta2.AppWrapperConditionUnhealthy,
+	}
+	if len(info.Conditions) != len(expected) {
+		t.Fatalf("expected %d conditions, got %d", len(expected), len(info.Conditions))
+	}
+	for i, conditionType := range expected {
+		if info.Conditions[i].Type != conditionType {
+			t.Errorf("expected condition %d to be %s, got %s", i, conditionType, info.Conditions[i].Type)
+		}
+	}
+	if len(info.Components) != 1 || info.Components[0].Kind != "Job" || len(info.Components[0].Conditions) != 1 {
+		t.Errorf("unexpected components: %+v", info.Components)
+	}
+
+	aw.Annotations = map[string]string{appwrapperv1beta2.RetryLimitAnnotation: "5"}
+	if limit := job.StatusInfo().RetryLimit; limit != 5 {
+		t.Errorf("expected annotated retry limit 5, got %d", limit)
+	}
+}
+
+func TestAppWrapperQueueAndRunDuration(t *testing.T) {
+	created := time.Now().Add(-2 * time.Hour)
+	at := func(d time.Duration) metav1.Time {
+		return metav1.NewTime(created.Add(d))
+	}
+	newJob := func(phase appwrapperv1beta2.AppWrapperPhase, conditions ...metav1.Condition) *AppWrapperJob {
+		aw := newTestAppWrapper("aw", phase, conditions...)
+		aw.CreationTimestamp = metav1.NewTime(created)
+		return &AppWrapperJob{appwrapper: aw}
+	}
+	approx := func(name string, got, want time.Duration) {
+		if diff := got - want; diff < -time.Minute || diff > time.Minute {
+			t.Errorf("%s: expected about %v, got %v", name, want, got)
+		}
+	}
+
+	queuing := newJob(appwrapperv1beta2.AppWrapperSuspended)
+	approx("queuing last admitted after", queuing.LastAdmittedAfter(), 2*time.Hour)
+	approx("queuing duration", queuing.Duration(), 0)
+
+	running := newJob(appwrapperv1beta2.AppWrapperRunning,
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionQuotaReserved, Status: metav1.ConditionTrue, LastTransitionTime: at(30 * time.Minute)},
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionPodsReady, Status: metav1.ConditionTrue, LastTransitionTime: at(time.Hour)},
+	)
+	approx("running last admitted after", running.LastAdmittedAfter(), 30*time.Minute)
+	approx("running duration", running.Duration(), time.Hour)
+	if running.FinishTime() != nil {
+		t.Errorf("expected running job to have no finish time")
+	}
+
+	succeeded := newJob(appwrapperv1beta2.AppWrapperSucceeded,
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionQuotaReserved, Status: metav1.ConditionTrue, LastTransitionTime: at(30 * time.Minute)},
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionPodsReady, Status: metav1.ConditionFalse, Reason: string(appwrapperv1beta2.AppWrapperSucceeded), LastTransitionTime: at(90 * time.Minute)},
+		// the resources are deleted after successTTL
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionResourcesDeployed, Status: metav1.ConditionFalse, Reason: "SuccessTTLExceeded", LastTransitionTime: at(110 * time.Minute)},
+	)
+	podStart := at(time.Hour)
+	succeeded.pods = []*corev1.Pod{{Status: corev1.PodStatus{StartTime: &podStart}}}
+	approx("succeeded last admitted after", succeeded.LastAdmittedAfter(), 30*time.Minute)
+	approx("succeeded duration", succeeded.Duration(), 30*time.Minute)
+	if finish := succeeded.FinishTime(); finish == nil || !finish.Equal(&metav1.Time{Time: created.Add(90 * time.Minute)}) {
+		t.Errorf("expected finish time at the PodsReady transition, got %v", finish)
+	}
+
+	failed := newJob(appwrapperv1beta2.AppWrapperFailed,
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionUnhealthy, Status: metav1.ConditionTrue, Reason: "FoundFailedPods", LastTransitionTime: at(40 * time.Minute)},
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionResourcesDeployed, Status: metav1.ConditionFalse, Reason: string(appwrapperv1beta2.AppWrapperFailed), LastTransitionTime: at(50 * time.Minute)},
+		metav1.Condition{Type: appwrapperv1beta2.AppWrapperConditionQuotaReserved, Status: metav1.ConditionFalse, Reason: string(appwrapperv1beta2.AppWrapperFailed), LastTransitionTime: at(55 * time.Minute)},
+	)
+	if finish := failed.FinishTime(); finish == nil || !finish.Equal(&metav1.Time{Time: created.Add(50 * time.Minute)}) {
+		t.Errorf("expected finish time at the first transition to Failed, got %v", finish)
+	}
+	failed.appwrapper.Status.Conditions = failed.appwrapper.Status.Conditions[:1]
+	if finish := failed.FinishTime(); finish == nil || !finish.Equal(&metav1.Time{Time: created.Add(40 * time.Minute)}) {
+		t.Errorf("expected finish time at the Unhealthy transition, got %v", finish)
+	}
+}
+
+func newTestComponent(t *testing.T, object interface{}, replicas ...int32) appwrapperv1beta2.AppWrapperComponent {
+	raw, err := json.Marshal(object)
+	if err != nil {
+		t.Fatalf("failed to marshal component: %v", err)
+	}
+	component := appwrapperv1beta2.AppWrapperComponent{Template: runtime.RawExtension{Raw: raw}}
+	for i := range replicas {
+		component.DeclaredPodSets = append(component.DeclaredPodSets, appwrapperv1beta2.AppWrapperPodSet{Replicas: &replicas[i]})
+	}
+	return component
+}
+
+func TestAppWrapperTypedComponents(t *testing.T) {
+	newPod := func(phase corev1.PodPhase, labels, annotations map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "aw-pod", Labels: labels, Annotations: annotations},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	t.Run("volcano", func(t *testing.T) {
+		aw := newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning)
+		aw.Spec.Components = []appwrapperv1beta2.AppWrapperComponent{newTestComponent(t, &volcanov1alpha1.Job{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "batch.volcano.sh/v1alpha1", Kind: "Job"},
+			ObjectMeta: metav1.ObjectMeta{Name: "aw"},
+			Spec: volcanov1alpha1.JobSpec{Tasks: []volcanov1alpha1.TaskSpec{
+				{Name: "trainer", Replicas: 4},
+				{Name: "evaluator", Replicas: 1},
+			}},
+		}, 4, 1)}
+		volcanoPod := func(task, index string, phase corev1.PodPhase) *corev1.Pod {
+			return newPod(phase,
+				map[string]string{volcanov1alpha1.JobNameKey: "aw"},
+				map[string]string{volcanov1alpha1.TaskSpecKey: task, volcanoTaskIndexAnnotation: index})
+		}
+		at := NewAppWrapperJobTrainerWithClient(nil, fake.NewSimpleClientset(), nil, true)
+		if !at.isChiefPod(aw, volcanoPod("trainer", "0", corev1.PodRunning)) {
+			t.Errorf("expected first trainer to be the chief")
+		}
+		if at.isChiefPod(aw, volcanoPod("evaluator", "0", corev1.PodRunning)) {
+			t.Errorf("expected first pod of the second task not to be the chief")
+		}
+
+		job := &AppWrapperJob{appwrapper: aw, pods: []*corev1.Pod{
+			volcanoPod("trainer", "0", corev1.PodRunning),
+			volcanoPod("trainer", "1", corev1.PodRunning),
+			volcanoPod("trainer", "2", corev1.PodRunning),
+			volcanoPod("trainer", "3", corev1.PodPending),
+		}}
+		components := job.StatusInfo().Components
+		if len(components) != 1 || components[0].Kind != "Job" || components[0].ExpectedPods != 5 {
+			t.Fatalf("unexpected components: %+v", components)
+		}
+		replicas := components[0].Replicas
+		if len(replicas) != 2 || replicas[0].Role != "trainer" || replicas[0].Running != 3 || replicas[0].Expected != 4 {
+			t.Errorf("expected 3/4 trainers running, got %+v", replicas)
+		}
+		if replicas[1].Running != 0 || replicas[1].Expected != 1 {
+			t.Errorf("expected 0/1 evaluator running, got %+v", replicas[1])
+		}
+	})
+
+	t.Run("pytorch", func(t *testing.T) {
+		one, three := int32(1), int32(3)
+		policy := commonv1.CleanPodPolicyRunning
+		aw := newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning)
+		aw.Spec.Components = []appwrapperv1beta2.AppWrapperComponent{newTestComponent(t, &pytorchv1.PyTorchJob{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "kubeflow.org/v1", Kind: "PyTorchJob"},
+			ObjectMeta: metav1.ObjectMeta{Name: "aw"},
+			Spec: pytorchv1.PyTorchJobSpec{
+				CleanPodPolicy: &policy,
+				PyTorchReplicaSpecs: map[pytorchv1.PyTorchReplicaType]*commonv1.ReplicaSpec{
+					pytorchv1.PyTorchReplicaTypeMaster: {Replicas: &one},
+					pytorchv1.PyTorchReplicaTypeWorker: {Replicas: &three},
+				},
+			},
+		}, 1, 3)}
+		pytorchPod := func(replicaType, index string) *corev1.Pod {
+			return newPod(corev1.PodRunning, map[string]string{
+				TrainingReplicaTypeLabel:  replicaType,
+				TrainingReplicaIndexLabel: index,
+				TrainingJobNameLabel:      "aw",
+			}, nil)
+		}
+		otherJobPod := func(labels map[string]string) *corev1.Pod {
+			labels[TrainingReplicaTypeLabel] = "master"
+			labels[TrainingReplicaIndexLabel] = "0"
+			return newPod(corev1.PodRunning, labels, nil)
+		}
+		at := NewAppWrapperJobTrainerWithClient(nil, fake.NewSimpleClientset(), nil, true)
+		if !at.isChiefPod(aw, pytorchPod("master", "0")) {
+			t.Errorf("expected master to be the chief")
+		}
+		if at.isChiefPod(aw, pytorchPod("worker", "0")) {
+			t.Errorf("expected first worker not to be the chief when a master is declared")
+		}
+		for _, labels := range []map[string]string{
+			{TrainingJobNameLabel: "other"},
+			{labelPyTorchJobName: "other"},
+			{"release": "other"},
+		} {
+			if role, _, ok := decodeAppWrapperComponents(aw)[0].roleOfPod(otherJobPod(labels)); ok {
+				t.Errorf("expected the pod labeled %v to have no role, got %v", labels, role)
+			}
+		}
+
+		job := &AppWrapperJob{appwrapper: aw, pods: []*corev1.Pod{pytorchPod("master", "0"), pytorchPod("worker", "0"), otherJobPod(map[string]string{TrainingJobNameLabel: "other"})}}
+		component := job.StatusInfo().Components[0]
+		if component.CleanPodPolicy != "Running" {
+			t.Errorf("expected clean pod policy Running, got %q", component.CleanPodPolicy)
+		}
+		if len(component.Replicas) != 2 || component.Replicas[1].Role != "Worker" || component.Replicas[1].Running != 1 || component.Replicas[1].Expected != 3 {
+			t.Errorf("expected 1/3 workers running, got %+v", component.Replicas)
+		}
+	})
+
+	t.Run("mpijob", func(t *testing.T) {
+		two := int32(2)
+		aw := newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning)
+		// the podSet of a job submitted by an older chart only declares the workers
+		aw.Spec.Components = []appwrapperv1beta2.AppWrapperComponent{newTestComponent(t, &mpiv1alpha1.MPIJob{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "kubeflow.org/v1alpha1", Kind: "MPIJob"},
+			ObjectMeta: metav1.ObjectMeta{Name: "aw"},
+			Spec:       mpiv1alpha1.MPIJobSpec{Replicas: &two},
+		}, 2)}
+		job := &AppWrapperJob{appwrapper: aw, pods: []*corev1.Pod{
+			newPod(corev1.PodRunning, map[string]string{mpiRoleTypeLabel: "launcher"}, nil),
+			newPod(corev1.PodRunning, map[string]string{mpiRoleTypeLabel: "worker"}, nil),
+		}}
+		component := job.StatusInfo().Components[0]
+		if component.ExpectedPods != 3 {
+			t.Errorf("expected 2 workers and the launcher, got %d pods", component.ExpectedPods)
+		}
+
+		// the launcher is declared in its own podSet
+		aw.Spec.Components = []appwrapperv1beta2.AppWrapperComponent{newTestComponent(t, &mpiv1alpha1.MPIJob{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "kubeflow.org/v1alpha1", Kind: "MPIJob"},
+			ObjectMeta: metav1.ObjectMeta{Name: "aw"},
+			Spec:       mpiv1alpha1.MPIJobSpec{Replicas: &two},
+		}, 1, 2)}
+		if component := job.StatusInfo().Components[0]; component.ExpectedPods != 3 {
+			t.Errorf("expected the launcher podSet and 2 workers, got %d pods", component.ExpectedPods)
+		}
+	})
+}
+
+func TestAppWrapperInnerKindChief(t *testing.T) {
+	one, two := int32(1), int32(2)
+	newPod := func(name string, labels, annotations map[string]string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, Annotations: annotations}}
+	}
+	tfJob := func(replicaTypes ...tfv1.TFReplicaType) *tfv1.TFJob {
+		specs := map[tfv1.TFReplicaType]*commonv1.ReplicaSpec{}
+		for _, replicaType := range replicaTypes {
+			specs[replicaType] = &commonv1.ReplicaSpec{Replicas: &two}
+		}
+		return &tfv1.TFJob{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "kubeflow.org/v1", Kind: "TFJob"},
+			ObjectMeta: metav1.ObjectMeta{Name: "aw"},
+			Spec:       tfv1.TFJobSpec{TFReplicaSpecs: specs},
+		}
+	}
+	tfPod := func(replicaType, index string) *corev1.Pod {
+		return newPod("aw-pod", map[string]string{TrainingReplicaTypeLabel: replicaType, TrainingReplicaIndexLabel: index}, nil)
+	}
+
+	tests := []struct {
+		name     string
+		object   interface{}
+		chief    *corev1.Pod
+		notChief *corev1.Pod
+	}{
+		{
+			name:     "tfjob with chief",
+			object:   tfJob(tfv1.TFReplicaTypeChief, tfv1.TFReplicaTypePS, tfv1.TFReplicaTypeWorker),
+			chief:    tfPod("chief", "0"),
+			notChief: tfPod("worker", "0"),
+		},
+		{
+			name:     "tfjob without chief",
+			object:   tfJob(tfv1.TFReplicaTypePS, tfv1.TFReplicaTypeWorker),
+			chief:    tfPod("worker", "0"),
+			notChief: tfPod("ps", "0"),
+		},
+		{
+			name: "mpijob",
+			object: &mpiv1alpha1.MPIJob{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "kubeflow.org/v1alpha1", Kind: "MPIJob"},
+				ObjectMeta: metav1.ObjectMeta{Name: "aw"},
+				Spec:       mpiv1alpha1.MPIJobSpec{Replicas: &two},
+			},
+			chief:    newPod("aw-launcher-x7k2p", map[string]string{mpiRoleTypeLabel: "launcher"}, nil),
+			notChief: newPod("aw-worker-0", map[string]string{mpiRoleTypeLabel: "worker"}, nil),
+		},
+		{
+			name: "deepspeedjob",
+			object: &etv1alpha1.TrainingJob{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "kai.alibabacloud.com/v1alpha1", Kind: "TrainingJob"},
+				ObjectMeta: metav1.ObjectMeta{Name: "aw"},
+				Spec: etv1alpha1.TrainingJobSpec{ETReplicaSpecs: etv1alpha1.ETReplicaSpecs{
+					Worker: &etv1alpha1.ETReplicaSpec{Replicas: &two},
+				}},
+			},
+			chief:    newPod("aw-launcher", map[string]string{deepspeedLabelTrainingJobRole: "launcher"}, nil),
+			notChief: newPod("aw-worker-0", map[string]string{deepspeedLabelTrainingJobRole: "worker"}, nil),
+		},
+		{
+			name: "rayjob",
+			object: &rayv1.RayJob{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "ray.io/v1", Kind: "RayJob"},
+				ObjectMeta: metav1.ObjectMeta{Name: "aw"},
+				Spec: rayv1.RayJobSpec{RayClusterSpec: &rayv1.RayClusterSpec{
+					WorkerGroupSpecs: []rayv1.WorkerGroupSpec{{GroupName: "worker", Replicas: &two}},
+				}},
+			},
+			chief:    newPod("aw-head-abcde", map[string]string{rayNodeTypeLabel: "head"}, nil),
+			notChief: newPod("aw-worker-0", map[string]string{rayNodeTypeLabel: "worker", rayNodeGroupLabel: "worker"}, nil),
+		},
+		{
+			name: "batch job",
+			object: &batchv1.Job{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+				ObjectMeta: metav1.ObjectMeta{Name: "aw"},
+				Spec:       batchv1.JobSpec{Parallelism: &two, Completions: &two},
+			},
+			chief:    newPod("aw-0-abcde", map[string]string{"job-name": "aw"}, map[string]string{batchv1.JobCompletionIndexAnnotation: "0"}),
+			notChief: newPod("aw-1-fghij", map[string]string{"job-name": "aw"}, map[string]string{batchv1.JobCompletionIndexAnnotation: "1"}),
+		},
+	}
+	at := NewAppWrapperJobTrainerWithClient(nil, fake.NewSimpleClientset(), nil, true)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			aw := newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning)
+			aw.Spec.Components = []appwrapperv1beta2.AppWrapperComponent{newTestComponent(t, test.object, one)}
+			if !at.isChiefPod(aw, test.chief) {
+				t.Errorf("expected pod %s to be the chief", test.chief.Name)
+			}
+			if at.isChiefPod(aw, test.notChief) {
+				t.Errorf("expected pod %s not to be the chief", test.notChief.Name)
+			}
+		})
+	}
+}
+
+func TestAppWrapperAscendRankTable(t *testing.T) {
+	npu := corev1.ResourceList{"huawei.com/Ascend910": resource.MustParse("8")}
+	aw := newTestAppWrapper("aw", appwrapperv1beta2.AppWrapperRunning)
+	aw.Spec.Components = []appwrapperv1beta2.AppWrapperComponent{newTestComponent(t, &volcanov1alpha1.Job{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch.volcano.sh/v1alpha1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{Name: "aw"},
+		Spec: volcanov1alpha1.JobSpec{Tasks: []volcanov1alpha1.TaskSpec{{
+			Name:     "worker",
+			Replicas: 2,
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "worker", Resources: corev1.ResourceRequirements{Limits: npu}}},
+				Volumes: []corev1.Volume{{Name: "ascend-rank-table", VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "rings-config-aw"}},
+				}}},
+			}},
+		}}},
+	}, 2)}
+	ascendPod := func(name, index, serverID string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{volcanov1alpha1.JobNameKey: "aw"},
+			Annotations: map[string]string{
+				volcanov1alpha1.TaskSpecKey:      "worker",
+				volcanoTaskIndexAnnotation:       index,
+				ranktable.DeviceConfigAnnotation: `{"pod_name":"` + name + `","server_id":"` + serverID + `","devices":[{"device_id":"0","device_ip":"192.168.100.1"},{"device_id":"1","device_ip":"192.168.100.2"}]}`,
+			},
+		}}
+	}
+
+	job := &AppWrapperJob{appwrapper: aw, pods: []*corev1.Pod{ascendPod("aw-worker-1", "1", "10.0.0.2")}}
+	rankTable := job.StatusInfo().RankTable
+	if rankTable == nil || rankTable.ConfigMap != "rings-config-aw" || rankTable.ExpectedServers != 2 {
+		t.Fatalf("unexpected rank table: %+v", rankTable)
+	}
+	if rankTable.Status != "initializing" || len(rankTable.Servers) != 1 {
+		t.Errorf("expected the rank table to be initializing with 1 server, got %+v", rankTable)
+	}
+
+	job.pods = append(job.pods, ascendPod("aw-worker-0", "0", "10.0.0.1"))
+	rankTable = job.StatusInfo().RankTable
+	if rankTable.Status != "completed" || len(rankTable.Servers) != 2 {
+		t.Fatalf("expected the rank table to be completed with 2 servers, got %+v", rankTable)
+	}
+	if rankTable.Servers[0].Pod != "aw-worker-0" || rankTable.Servers[0].FirstRank != 0 || rankTable.Servers[1].FirstRank != 2 {
+		t.Errorf("expected pods ranked by task index, got %+v", rankTable.Servers)
+	}
+
+	aw.Spec.Components[0] = newTestComponent(t, &volcanov1alpha1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch.volcano.sh/v1alpha1", Kind: "Job"},
+		Spec:     volcanov1alpha1.JobSpec{Tasks: []volcanov1alpha1.TaskSpec{{Name: "worker", Replicas: 2}}},
+	}, 2)
+	if rankTable := job.StatusInfo().RankTable; rankTable != nil {
+		t.Errorf("expected no rank table without the rank table volume, got %+v", rankTable)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
@@ -317,6 +318,11 @@ func (dst *DeepSpeedJobTrainer) Type() types.TrainingJobType {
 	return dst.trainerType
 }
 
+// JobResource returns the resource of the jobs watched by wait
+func (dst *DeepSpeedJobTrainer) JobResource() schema.GroupVersionResource {
+	return v1alpha1.SchemeGroupVersion.WithResource("trainingjobs")
+}
+
 // check if it's et job
 func (dst *DeepSpeedJobTrainer) IsSupported(name, ns string) bool {
 	if !dst.enabled {
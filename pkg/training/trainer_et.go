@@ -26,6 +26,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/kubeflow/arena/pkg/apis/types"
@@ -288,6 +289,11 @@ func (ejt *ETJobTrainer) Type() types.TrainingJobType {
 	return ejt.trainerType
 }
 
+// JobResource returns the resource of the jobs watched by wait
+func (ejt *ETJobTrainer) JobResource() schema.GroupVersionResource {
+	return v1alpha1.SchemeGroupVersion.WithResource("trainingjobs")
+}
+
 // check if it's et job
 func (ejt *ETJobTrainer) IsSupported(name, ns string) bool {
 	if !ejt.enabled {
@@ -20,6 +20,8 @@ import (
 	"github.com/kubeflow/arena/pkg/apis/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -93,3 +95,29 @@ type Trainer interface {
 	// List all tf training jobs
 	ListTrainingJobs(namespace string, allNamespace bool) ([]TrainingJob, error)
 }
+
+// SuspendableTrainer is implemented by the trainers whose jobs can be suspended and resumed
+type SuspendableTrainer interface {
+	Trainer
+
+	// Suspend or resume the training job
+	SuspendTrainingJob(name, namespace string, suspend bool) error
+}
+
+// WatchableTrainer is implemented by the trainers whose jobs can be watched, wait is driven by the watch events
+type WatchableTrainer interface {
+	Trainer
+
+	// JobResource returns the resource of the training jobs
+	JobResource() schema.GroupVersionResource
+}
+
+// ObjectStatusTrainer is implemented by the watchable trainers which can tell the status of a training job
+// from its watched object, wait evaluates the watch events without getting the whole training job
+type ObjectStatusTrainer interface {
+	WatchableTrainer
+
+	// StatusOfObject returns the status of the training job of the watched object, ok is false if the
+	// status also depends on the pods of the job
+	StatusOfObject(obj *unstructured.Unstructured) (status types.TrainingJobStatus, ok bool, err error)
+}
@@ -28,6 +28,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 
 	"time"
@@ -266,6 +267,11 @@ func (tt *MPIJobTrainer) Type() types.TrainingJobType {
 	return tt.trainerType
 }
 
+// JobResource returns the resource of the jobs watched by wait
+func (tt *MPIJobTrainer) JobResource() schema.GroupVersionResource {
+	return v1alpha1.SchemeGroupVersion.WithResource("mpijobs")
+}
+
 // check if it's TensorFlow job
 func (tt *MPIJobTrainer) IsSupported(name, ns string) bool {
 	if !tt.enabled {
@@ -22,6 +22,9 @@ import (
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
@@ -260,6 +263,21 @@ func (tt *PyTorchJobTrainer) Type() types.TrainingJobType {
 	return tt.trainerType
 }
 
+// JobResource returns the resource of the jobs watched by wait
+func (tt *PyTorchJobTrainer) JobResource() schema.GroupVersionResource {
+	return pytorchv1.SchemeGroupVersion.WithResource("pytorchjobs")
+}
+
+// StatusOfObject returns the status of the watched job, a running job may still have pending pods
+func (tt *PyTorchJobTrainer) StatusOfObject(obj *unstructured.Unstructured) (types.TrainingJobStatus, bool, error) {
+	job := &pytorchv1.PyTorchJob{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, job); err != nil {
+		return "", false, err
+	}
+	status := types.TrainingJobStatus((&PyTorchJob{pytorchjob: job}).GetStatus())
+	return status, status != types.TrainingJobRunning, nil
+}
+
 // check if it's TensorFlow job
 func (tt *PyTorchJobTrainer) IsSupported(name, ns string) bool {
 	if !tt.enabled {
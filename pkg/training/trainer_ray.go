@@ -27,6 +27,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
@@ -268,6 +269,11 @@ func (rjt *RayJobTrainer) Type() types.TrainingJobType {
 	return rjt.trainerType
 }
 
+// JobResource returns the resource of the jobs watched by wait
+func (rjt *RayJobTrainer) JobResource() schema.GroupVersionResource {
+	return rayv1.SchemeGroupVersion.WithResource("rayjobs")
+}
+
 // check if it's ray job
 func (rjt *RayJobTrainer) IsSupported(name, ns string) bool {
 	if !rjt.enabled {
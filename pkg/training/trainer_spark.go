@@ -28,6 +28,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -283,6 +284,11 @@ func (st *SparkJobTrainer) Type() types.TrainingJobType {
 	return st.trainerType
 }
 
+// JobResource returns the resource of the jobs watched by wait
+func (st *SparkJobTrainer) JobResource() schema.GroupVersionResource {
+	return v1beta2.SchemeGroupVersion.WithResource("sparkapplications")
+}
+
 func (st *SparkJobTrainer) IsSupported(name, ns string) bool {
 	if !st.enabled {
 		return false
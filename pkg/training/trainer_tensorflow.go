@@ -23,6 +23,9 @@ import (
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
@@ -277,6 +280,21 @@ func (tt *TensorFlowJobTrainer) Type() types.TrainingJobType {
 	return tt.trainerType
 }
 
+// JobResource returns the resource of the jobs watched by wait
+func (tt *TensorFlowJobTrainer) JobResource() schema.GroupVersionResource {
+	return tfv1.SchemeGroupVersion.WithResource("tfjobs")
+}
+
+// StatusOfObject returns the status of the watched job, a running job may still have pending pods
+func (tt *TensorFlowJobTrainer) StatusOfObject(obj *unstructured.Unstructured) (types.TrainingJobStatus, bool, error) {
+	job := &tfv1.TFJob{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, job); err != nil {
+		return "", false, err
+	}
+	status := types.TrainingJobStatus((&TensorFlowJob{tfjob: job}).GetStatus())
+	return status, status != types.TrainingJobRunning, nil
+}
+
 // check if it's TensorFlow job
 func (tt *TensorFlowJobTrainer) IsSupported(name, namespace string) bool {
 	if !tt.enabled {
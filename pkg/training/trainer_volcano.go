@@ -26,6 +26,8 @@ import (
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
@@ -41,6 +43,7 @@ type VolcanoJob struct {
 	chiefPod     *corev1.Pod
 	requestedGPU int64
 	allocatedGPU int64
+	podGroups    []*types.PodGroupInfo
 }
 
 func (vj *VolcanoJob) Name() string {
@@ -74,6 +77,11 @@ func (vj *VolcanoJob) GetLabels() map[string]string {
 	return vj.volcanoJob.Labels
 }
 
+// PodGroups returns the gang scheduling state of the job, empty if its PodGroup is not found
+func (vj *VolcanoJob) PodGroups() []*types.PodGroupInfo {
+	return vj.podGroups
+}
+
 func (vj *VolcanoJob) GetStatus() (status string) {
 
 	defer func() {
@@ -252,6 +260,7 @@ func (vj *VolcanoJob) GetPriorityClass() string {
 type VolcanoJobTrainer struct {
 	client           *kubernetes.Clientset
 	volcanoJobClient *versioned.Clientset
+	dynamicClient    dynamic.Interface
 	trainerType      types.TrainingJobType
 	enabled          bool
 }
@@ -266,9 +275,18 @@ func NewVolcanoJobTrainer() Trainer {
 	} else {
 		log.Debugf("VolcanoJobTrainer is disabled,reason: %v", err)
 	}
+	// the PodGroups are read by the dynamic client, the job is shown without them if it can not be created
+	// a nil *DynamicClient must not be stored as a non-nil dynamic.Interface
+	var dynamicClient dynamic.Interface
+	if client, err := dynamic.NewForConfig(config.GetArenaConfiger().GetRestConfig()); err != nil {
+		log.Debugf("VolcanoJobTrainer runs without PodGroups, reason: %v", err)
+	} else {
+		dynamicClient = client
+	}
 	log.Debugf("Succeed to init Volcano job trainer")
 	return &VolcanoJobTrainer{
 		volcanoJobClient: volcanoClient,
+		dynamicClient:    dynamicClient,
 		client:           config.GetArenaConfiger().GetClientSet(),
 		trainerType:      types.VolcanoTrainingJob,
 		enabled:          enable,
@@ -284,6 +302,11 @@ func (st *VolcanoJobTrainer) Type() types.TrainingJobType {
 	return st.trainerType
 }
 
+// JobResource returns the resource of the jobs watched by wait
+func (st *VolcanoJobTrainer) JobResource() schema.GroupVersionResource {
+	return v1alpha1.SchemeGroupVersion.WithResource("jobs")
+}
+
 func (st *VolcanoJobTrainer) IsSupported(name, ns string) bool {
 	if !st.enabled {
 		return false
@@ -316,7 +339,7 @@ func (st *VolcanoJobTrainer) GetTrainingJob(name, namespace string) (TrainingJob
 	}
 	// filter pods and find chief pod
 	filterPods, chiefPod := getPodsOfVolcanoJob(volcanoJob, st, pods)
-	return &VolcanoJob{
+	job := &VolcanoJob{
 		BasicJobInfo: &BasicJobInfo{
 			resources: podResources(filterPods),
 			name:      name,
@@ -325,7 +348,15 @@ func (st *VolcanoJobTrainer) GetTrainingJob(name, namespace string) (TrainingJob
 		chiefPod:    chiefPod,
 		pods:        filterPods,
 		trainerType: st.Type(),
-	}, nil
+	}
+	podGroups := findPodGroups(st.dynamicClient, namespace, filterPods, func() [][]string {
+		return [][]string{podGroupNamesOfVolcanoJob(volcanoJob.Name, string(volcanoJob.UID))}
+	})
+	for _, podGroup := range podGroups {
+		job.resources = append(job.resources, podGroup.resource())
+		job.podGroups = append(job.podGroups, podGroup.info())
+	}
+	return job, nil
 }
 
 func (st *VolcanoJobTrainer) ListTrainingJobs(namespace string, allNamespace bool) ([]TrainingJob, error) {
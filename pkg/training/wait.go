@@ -0,0 +1,203 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// waitResyncPeriod is the period to check the job again without watch events, the status of some
+// jobs is also derived from their pods which are not watched
+const waitResyncPeriod = 30 * time.Second
+
+// jobWaiter waits for a training job by watching its resource, the status is checked again on every
+// change of the job and polled every resync period
+type jobWaiter struct {
+	client       dynamic.Interface
+	resource     schema.GroupVersionResource
+	name         string
+	namespace    string
+	trainer      types.TrainingJobType
+	resyncPeriod time.Duration
+	// getJob returns the current information of the job
+	getJob func() (*types.TrainingJobInfo, error)
+	// statusOfObject returns the status of the job from its watched object, nil if the trainer can't tell it
+	statusOfObject func(obj *unstructured.Unstructured) (types.TrainingJobStatus, bool, error)
+}
+
+// WaitTrainingJob blocks until the training job satisfies the predicate. It returns types.ErrWaitConditionUnreachable
+// if the job is finished without satisfying it, and types.ErrWaitTimeout if the deadline of the context is exceeded.
+// The watch events of the trainers implementing ObjectStatusTrainer are evaluated on the status of the watched
+// object, the predicate only gets the name, namespace, trainer and status of the job until it is satisfied
+func WaitTrainingJob(ctx context.Context, jobName, namespace string, jobType types.TrainingJobType, predicate types.TrainingJobPredicate) (*types.TrainingJobInfo, error) {
+	job, err := SearchTrainingJob(jobName, namespace, jobType)
+	if err != nil {
+		return nil, err
+	}
+	trainer := GetAllTrainers()[job.Trainer()]
+	waiter := &jobWaiter{
+		name:         jobName,
+		namespace:    namespace,
+		trainer:      job.Trainer(),
+		resyncPeriod: waitResyncPeriod,
+		getJob: func() (*types.TrainingJobInfo, error) {
+			job, err := trainer.GetTrainingJob(jobName, namespace)
+			if err != nil {
+				return nil, err
+			}
+			return BuildJobInfo(job, false, []*corev1.Service{}, []*corev1.Node{}), nil
+		},
+	}
+	if watchable, ok := trainer.(WatchableTrainer); ok {
+		waiter.resource = watchable.JobResource()
+		waiter.client, err = dynamic.NewForConfig(config.GetArenaConfiger().GetRestConfig())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if objectStatus, ok := trainer.(ObjectStatusTrainer); ok {
+		waiter.statusOfObject = objectStatus.StatusOfObject
+	}
+	return waiter.wait(ctx, predicate)
+}
+
+func (w *jobWaiter) wait(ctx context.Context, predicate types.TrainingJobPredicate) (*types.TrainingJobInfo, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// changed keeps only the latest watched object, nil after the job is deleted
+	changed := make(chan *unstructured.Unstructured, 1)
+	notify := func(obj interface{}) {
+		latest, _ := obj.(*unstructured.Unstructured)
+		for {
+			select {
+			case changed <- latest:
+				return
+			default:
+			}
+			select {
+			case <-changed:
+			default:
+			}
+		}
+	}
+	// the job is also checked on every tick in case the list or watch of the informer keeps failing
+	ticker := time.NewTicker(w.resyncPeriod)
+	defer ticker.Stop()
+	if w.client != nil {
+		_, controller := cache.NewInformer(w.listWatch(ctx), &unstructured.Unstructured{}, w.resyncPeriod, cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(obj interface{}) { notify(obj) },
+			UpdateFunc: func(oldObj, newObj interface{}) { notify(newObj) },
+			DeleteFunc: func(obj interface{}) { notify(nil) },
+		})
+		go controller.Run(ctx.Done())
+	}
+
+	var info *types.TrainingJobInfo
+	// obj is the watched object to evaluate, the job is got again when it is nil
+	var obj *unstructured.Unstructured
+	for {
+		current, err := w.jobInfo(obj, predicate)
+		switch {
+		case err == nil:
+			info = current
+			if predicate(info) {
+				return info, nil
+			}
+			if info.Status == types.TrainingJobSucceeded || info.Status == types.TrainingJobFailed {
+				return info, types.ErrWaitConditionUnreachable
+			}
+			log.Debugf("the training job %s is %s, keep waiting", w.name, info.Status)
+		case errors.Is(err, types.ErrTrainingJobNotFound):
+			return info, err
+		default:
+			log.Debugf("failed to get the training job %s, retry on the next change: %v", w.name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return info, types.ErrWaitTimeout
+			}
+			return info, ctx.Err()
+		case obj = <-changed:
+		case <-ticker.C:
+			obj = nil
+		}
+	}
+}
+
+// jobInfo returns the information of the job from the status of the watched object, the whole job is
+// only got if the trainer can't tell the status from the object or the status ends the wait
+func (w *jobWaiter) jobInfo(obj *unstructured.Unstructured, predicate types.TrainingJobPredicate) (*types.TrainingJobInfo, error) {
+	if obj == nil || w.statusOfObject == nil {
+		return w.getJob()
+	}
+	status, ok, err := w.statusOfObject(obj)
+	if err != nil {
+		log.Debugf("failed to get the status of the training job %s from the watched object: %v", w.name, err)
+		return w.getJob()
+	}
+	if !ok {
+		return w.getJob()
+	}
+	info := &types.TrainingJobInfo{
+		Name:      w.name,
+		Namespace: w.namespace,
+		Trainer:   w.trainer,
+		Status:    status,
+	}
+	if !predicate(info) && status != types.TrainingJobSucceeded && status != types.TrainingJobFailed {
+		return info, nil
+	}
+	job, err := w.getJob()
+	if err != nil {
+		log.Debugf("failed to get the training job %s, use the status of the watched object: %v", w.name, err)
+		return info, nil
+	}
+	return job, nil
+}
+
+// listWatch lists and watches the job only by the name field selector
+func (w *jobWaiter) listWatch(ctx context.Context) *cache.ListWatch {
+	selector := fields.OneTermEqualSelector("metadata.name", w.name).String()
+	client := w.client.Resource(w.resource).Namespace(w.namespace)
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = selector
+			return client.List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = selector
+			return client.Watch(ctx, options)
+		},
+	}
+}
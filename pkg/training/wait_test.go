@@ -0,0 +1,257 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+var testPyTorchJobResource = schema.GroupVersionResource{Group: "kubeflow.org", Version: "v1", Resource: "pytorchjobs"}
+
+// fakeJobStatus is the status returned by the stubbed getJob of the waiter
+type fakeJobStatus struct {
+	sync.Mutex
+	status types.TrainingJobStatus
+}
+
+func (f *fakeJobStatus) set(status types.TrainingJobStatus) {
+	f.Lock()
+	defer f.Unlock()
+	f.status = status
+}
+
+func (f *fakeJobStatus) getJob() (*types.TrainingJobInfo, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &types.TrainingJobInfo{Name: "test", Status: f.status}, nil
+}
+
+func newTestWaiter(status *fakeJobStatus) (*jobWaiter, *dynamicfake.FakeDynamicClient) {
+	job := &unstructured.Unstructured{}
+	job.SetAPIVersion("kubeflow.org/v1")
+	job.SetKind("PyTorchJob")
+	job.SetNamespace("default")
+	job.SetName("test")
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{testPyTorchJobResource: "PyTorchJobList"}, job)
+	return &jobWaiter{
+		client:       client,
+		resource:     testPyTorchJobResource,
+		name:         "test",
+		namespace:    "default",
+		resyncPeriod: time.Hour,
+		getJob:       status.getJob,
+	}, client
+}
+
+func TestJobWaiterWait(t *testing.T) {
+	testcases := []struct {
+		name      string
+		predicate types.TrainingJobPredicate
+		update    types.TrainingJobStatus
+		expected  types.TrainingJobStatus
+		err       error
+	}{
+		{
+			name:      "satisfied after the job is modified",
+			predicate: types.TrainingJobStatusIn(types.TrainingJobSucceeded),
+			update:    types.TrainingJobSucceeded,
+			expected:  types.TrainingJobSucceeded,
+		},
+		{
+			name:      "finished without satisfying the predicate",
+			predicate: types.TrainingJobStatusIn(types.TrainingJobSucceeded),
+			update:    types.TrainingJobFailed,
+			expected:  types.TrainingJobFailed,
+			err:       types.ErrWaitConditionUnreachable,
+		},
+		{
+			name:      "timeout",
+			predicate: types.TrainingJobStatusIn(types.TrainingJobRunning),
+			expected:  types.TrainingJobPending,
+			err:       types.ErrWaitTimeout,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			status := &fakeJobStatus{status: types.TrainingJobPending}
+			waiter, client := newTestWaiter(status)
+			if tc.update != "" {
+				go func() {
+					time.Sleep(100 * time.Millisecond)
+					status.set(tc.update)
+					job, err := client.Resource(testPyTorchJobResource).Namespace("default").Get(context.TODO(), "test", metav1.GetOptions{})
+					if err != nil {
+						t.Errorf("failed to get job: %v", err)
+						return
+					}
+					job.SetLabels(map[string]string{"status": string(tc.update)})
+					if _, err := client.Resource(testPyTorchJobResource).Namespace("default").Update(context.TODO(), job, metav1.UpdateOptions{}); err != nil {
+						t.Errorf("failed to update job: %v", err)
+					}
+				}()
+			}
+			ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+			defer cancel()
+			info, err := waiter.wait(ctx, tc.predicate)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if info == nil || info.Status != tc.expected {
+				t.Errorf("expected status %s, got %+v", tc.expected, info)
+			}
+		})
+	}
+}
+
+func TestJobWaiterNotFound(t *testing.T) {
+	status := &fakeJobStatus{status: types.TrainingJobRunning}
+	waiter, _ := newTestWaiter(status)
+	waiter.getJob = func() (*types.TrainingJobInfo, error) {
+		return nil, types.ErrTrainingJobNotFound
+	}
+	if _, err := waiter.wait(context.TODO(), types.TrainingJobStatusIn(types.TrainingJobSucceeded)); !errors.Is(err, types.ErrTrainingJobNotFound) {
+		t.Errorf("expected error %v, got %v", types.ErrTrainingJobNotFound, err)
+	}
+}
+
+func TestJobWaiterWatchFailed(t *testing.T) {
+	status := &fakeJobStatus{status: types.TrainingJobRunning}
+	waiter, client := newTestWaiter(status)
+	client.PrependReactor("list", "pytorchjobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	waiter.resyncPeriod = 50 * time.Millisecond
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		status.set(types.TrainingJobSucceeded)
+	}()
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	info, err := waiter.wait(ctx, types.TrainingJobStatusIn(types.TrainingJobSucceeded))
+	if err != nil {
+		t.Fatalf("expected the job to be polled when its watch fails, got %v", err)
+	}
+	if info.Status != types.TrainingJobSucceeded {
+		t.Errorf("expected status %s, got %s", types.TrainingJobSucceeded, info.Status)
+	}
+}
+
+func TestJobWaiterStatusOfObject(t *testing.T) {
+	status := &fakeJobStatus{status: types.TrainingJobPending}
+	waiter, client := newTestWaiter(status)
+	var calls int
+	var mu sync.Mutex
+	waiter.getJob = func() (*types.TrainingJobInfo, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		return status.getJob()
+	}
+	waiter.statusOfObject = func(obj *unstructured.Unstructured) (types.TrainingJobStatus, bool, error) {
+		if s, ok := obj.GetLabels()["status"]; ok {
+			return types.TrainingJobStatus(s), true, nil
+		}
+		return types.TrainingJobPending, true, nil
+	}
+	go func() {
+		for _, update := range []types.TrainingJobStatus{types.TrainingJobRunning, types.TrainingJobRunning, types.TrainingJobSucceeded} {
+			time.Sleep(100 * time.Millisecond)
+			status.set(update)
+			job, err := client.Resource(testPyTorchJobResource).Namespace("default").Get(context.TODO(), "test", metav1.GetOptions{})
+			if err != nil {
+				t.Errorf("failed to get job: %v", err)
+				return
+			}
+			job.SetLabels(map[string]string{"status": string(update)})
+			job.SetAnnotations(map[string]string{"update": time.Now().String()})
+			if _, err := client.Resource(testPyTorchJobResource).Namespace("default").Update(context.TODO(), job, metav1.UpdateOptions{}); err != nil {
+				t.Errorf("failed to update job: %v", err)
+			}
+		}
+	}()
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	info, err := waiter.wait(ctx, types.TrainingJobStatusIn(types.TrainingJobSucceeded))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Status != types.TrainingJobSucceeded {
+		t.Errorf("expected status %s, got %s", types.TrainingJobSucceeded, info.Status)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	// the job is only got before the first event and once the predicate is satisfied
+	if calls != 2 {
+		t.Errorf("expected the job to be got 2 times, got %d", calls)
+	}
+}
+
+func TestPyTorchJobStatusOfObject(t *testing.T) {
+	testcases := []struct {
+		name       string
+		conditions []interface{}
+		expected   types.TrainingJobStatus
+		ok         bool
+	}{
+		{
+			name:     "no conditions",
+			expected: types.TrainingJobPending,
+			ok:       true,
+		},
+		{
+			name:       "running may have pending pods",
+			conditions: []interface{}{map[string]interface{}{"type": "Running", "status": "True"}},
+			expected:   types.TrainingJobRunning,
+		},
+		{
+			name:       "succeeded",
+			conditions: []interface{}{map[string]interface{}{"type": "Succeeded", "status": "True"}},
+			expected:   types.TrainingJobSucceeded,
+			ok:         true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+			obj.SetAPIVersion("kubeflow.org/v1")
+			obj.SetKind("PyTorchJob")
+			obj.SetName("test")
+			if tc.conditions != nil {
+				obj.Object["status"] = map[string]interface{}{"conditions": tc.conditions}
+			}
+			status, ok, err := (&PyTorchJobTrainer{}).StatusOfObject(obj)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if status != tc.expected || ok != tc.ok {
+				t.Errorf("expected %s/%v, got %s/%v", tc.expected, tc.ok, status, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+)
+
+// watchRefreshInterval is the minimal interval between two refreshes, the changes in the interval are merged
+const watchRefreshInterval = 2 * time.Second
+
+// clearScreen moves the cursor to the top left corner and clears the terminal
+const clearScreen = "\033[H\033[2J"
+
+// watchTimeColumns are the columns whose values change with the time, they are ignored when the rows
+// are compared to find the changed ones
+var watchTimeColumns = map[string]bool{"AGE": true, "DURATION": true}
+
+// watchOptions defines the changes which trigger a refresh
+type watchOptions struct {
+	namespace string
+	// jobName limits the changes to a single job, the changes of all the jobs are watched if it is empty
+	jobName string
+	// resources are the resources of the jobs by their trainers
+	resources map[types.TrainingJobType]schema.GroupVersionResource
+	// events reports whether the events are watched as well
+	events bool
+}
+
+// trainerChanges are the trainers whose jobs or pods are changed since the last refresh
+type trainerChanges struct {
+	// all is set if the trainer of a change is unknown
+	all      bool
+	trainers map[types.TrainingJobType]bool
+}
+
+func (c trainerChanges) has(trainerType types.TrainingJobType) bool {
+	return c.all || c.trainers[trainerType]
+}
+
+// changeNotifier merges the changes until they are taken by the next refresh
+type changeNotifier struct {
+	lock    sync.Mutex
+	changes trainerChanges
+	signal  chan struct{}
+}
+
+func newChangeNotifier() *changeNotifier {
+	return &changeNotifier{
+		changes: trainerChanges{trainers: map[types.TrainingJobType]bool{}},
+		signal:  make(chan struct{}, 1),
+	}
+}
+
+// notify records the change of the trainer, an empty trainer type means the trainer is unknown
+func (n *changeNotifier) notify(trainerType types.TrainingJobType) {
+	n.lock.Lock()
+	if trainerType == "" {
+		n.changes.all = true
+	} else {
+		n.changes.trainers[trainerType] = true
+	}
+	n.lock.Unlock()
+	select {
+	case n.signal <- struct{}{}:
+	default:
+	}
+}
+
+// take returns the changes since the last call and resets them
+func (n *changeNotifier) take() trainerChanges {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	select {
+	case <-n.signal:
+	default:
+	}
+	changes := n.changes
+	n.changes = trainerChanges{trainers: map[types.TrainingJobType]bool{}}
+	return changes
+}
+
+// WatchTrainingJobList prints the training jobs like DisplayTrainingJobList and refreshes them on every change of
+// the jobs and their pods until the context is done, only the jobs of the changed trainers are listed again. The pods
+// are watched by the informers of the k8s resource accesser, so it must be initialized in daemon mode, and the
+// listing is served from its cache as well
+func WatchTrainingJobList(ctx context.Context, namespace string, allNamespaces bool, jobType types.TrainingJobType, format string) error {
+	if format != "" && format != "wide" {
+		return fmt.Errorf("--watch only supports the wide output format")
+	}
+	if jobType == types.UnknownTrainingJob {
+		return fmt.Errorf("unsupport job type,arena only supports: [%v]", utils.GetSupportTrainingJobTypesInfo())
+	}
+	options := watchOptions{
+		namespace: namespace,
+		resources: map[types.TrainingJobType]schema.GroupVersionResource{},
+	}
+	if allNamespaces {
+		options.namespace = metav1.NamespaceAll
+	}
+	trainers := map[types.TrainingJobType]Trainer{}
+	for trainerType, trainer := range GetAllTrainers() {
+		if !isNeededTrainingType(trainerType, jobType) || !trainer.IsEnabled() {
+			continue
+		}
+		trainers[trainerType] = trainer
+		if watchable, ok := trainer.(WatchableTrainer); ok {
+			options.resources[trainerType] = watchable.JobResource()
+		}
+	}
+	jobsOfTrainers := map[types.TrainingJobType][]TrainingJob{}
+	return watchAndPrint(ctx, options, func(w io.Writer, changes trainerChanges) error {
+		changed := map[types.TrainingJobType]Trainer{}
+		for trainerType, trainer := range trainers {
+			if changes.has(trainerType) {
+				changed[trainerType] = trainer
+			}
+		}
+		listed, err := listJobsOfTrainers(changed, namespace, allNamespaces)
+		if err != nil {
+			return err
+		}
+		// the jobs of the trainers failed to list are kept until their next change
+		for trainerType, trainerJobs := range listed {
+			jobsOfTrainers[trainerType] = trainerJobs
+		}
+		jobs := []TrainingJob{}
+		for _, trainerJobs := range jobsOfTrainers {
+			jobs = append(jobs, trainerJobs...)
+		}
+		jobs = makeTrainingJobOrderdByAge(jobs)
+		services, nodes := PrepareServicesAndNodesForTensorboard(jobs, allNamespaces)
+		jobInfos := []*types.TrainingJobInfo{}
+		for _, job := range jobs {
+			jobInfos = append(jobInfos, BuildJobInfo(job, false, services, nodes))
+		}
+		printTrainingJobTable(w, jobInfos, format, allNamespaces)
+		return nil
+	})
+}
+
+// WatchTrainingJob prints the training job like PrintTrainingJob and refreshes it on every change of the job, its
+// pods and its events if showEvents is set, until the context is done or the job is deleted
+func WatchTrainingJob(ctx context.Context, jobName, namespace string, jobType types.TrainingJobType, modelVersion *types.ModelVersion, format string, showEvents bool, showGPUs bool) error {
+	if format != "" && format != "wide" {
+		return fmt.Errorf("--watch only supports the wide output format")
+	}
+	job, err := SearchTrainingJob(jobName, namespace, jobType)
+	if err != nil {
+		return err
+	}
+	// search the job with its own type on the refreshes
+	jobType = job.Trainer()
+	options := watchOptions{
+		namespace: namespace,
+		jobName:   jobName,
+		resources: map[types.TrainingJobType]schema.GroupVersionResource{},
+		events:    showEvents,
+	}
+	if watchable, ok := GetAllTrainers()[jobType].(WatchableTrainer); ok {
+		options.resources[jobType] = watchable.JobResource()
+	}
+	return watchAndPrint(ctx, options, func(w io.Writer, _ trainerChanges) error {
+		job, err := SearchTrainingJob(jobName, namespace, jobType)
+		if err != nil {
+			return err
+		}
+		services, nodes := PrepareServicesAndNodesForTensorboard([]TrainingJob{job}, false)
+		jobInfo := BuildJobInfo(job, showGPUs, services, nodes)
+		patchModelInfo(jobInfo, modelVersion)
+		printSingleJobHelper(w, jobInfo, job.Resources(), showEvents, showGPUs)
+		return nil
+	})
+}
+
+// watchAndPrint prints the output of render, and renders it again with the changes when the watched objects are changed
+func watchAndPrint(ctx context.Context, options watchOptions, render func(w io.Writer, changes trainerChanges) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	notifier := newChangeNotifier()
+	if err := watchChanges(ctx, options, notifier.notify); err != nil {
+		return err
+	}
+	printer := newWatchPrinter(os.Stdout, isTerminal(os.Stdout))
+	changes := trainerChanges{all: true}
+	for {
+		var buffer bytes.Buffer
+		err := render(&buffer, changes)
+		switch {
+		case err == nil:
+			printer.print(buffer.String())
+		case errors.Is(err, types.ErrTrainingJobNotFound):
+			return err
+		default:
+			log.Warnf("failed to refresh the training jobs: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notifier.signal:
+		}
+		// merge the changes in the refresh interval
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchRefreshInterval):
+		}
+		changes = notifier.take()
+	}
+}
+
+// watchChanges calls notify with the trainer of the change when the jobs, pods or events of the options are changed,
+// the trainer is empty if it is unknown
+func watchChanges(ctx context.Context, options watchOptions, notify func(trainerType types.TrainingJobType)) error {
+	accesser := k8saccesser.GetK8sResourceAccesser()
+	podHandler := cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			pod, ok := obj.(*corev1.Pod)
+			if !ok || !inWatchNamespace(options, pod.Namespace) {
+				return false
+			}
+			release, ok := pod.Labels["release"]
+			return ok && (options.jobName == "" || release == options.jobName)
+		},
+		Handler: notifyHandler(func(obj interface{}) {
+			// the pods of the trainers are labeled with the trainer type
+			trainerType := types.TrainingJobType(obj.(*corev1.Pod).Labels["app"])
+			if _, ok := GetAllTrainers()[trainerType]; !ok {
+				trainerType = ""
+			}
+			notify(trainerType)
+		}),
+	}
+	if err := accesser.AddEventHandler(ctx, &corev1.Pod{}, podHandler); err != nil {
+		return fmt.Errorf("failed to watch pods: %v", err)
+	}
+	if options.events {
+		eventHandler := cache.FilteringResourceEventHandler{
+			FilterFunc: func(obj interface{}) bool {
+				event, ok := obj.(*corev1.Event)
+				if !ok || !inWatchNamespace(options, event.Namespace) {
+					return false
+				}
+				name := event.InvolvedObject.Name
+				return options.jobName == "" || name == options.jobName || strings.HasPrefix(name, options.jobName+"-")
+			},
+			Handler: notifyHandler(func(obj interface{}) { notify("") }),
+		}
+		if err := accesser.AddEventHandler(ctx, &corev1.Event{}, eventHandler); err != nil {
+			return fmt.Errorf("failed to watch events: %v", err)
+		}
+	}
+	if len(options.resources) == 0 {
+		return nil
+	}
+	client, err := dynamic.NewForConfig(config.GetArenaConfiger().GetRestConfig())
+	if err != nil {
+		return err
+	}
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, 0, options.namespace, func(listOptions *metav1.ListOptions) {
+		if options.jobName != "" {
+			listOptions.FieldSelector = fields.OneTermEqualSelector("metadata.name", options.jobName).String()
+		}
+	})
+	for trainerType, resource := range options.resources {
+		trainerType := trainerType
+		if _, err := factory.ForResource(resource).Informer().AddEventHandler(notifyHandler(func(obj interface{}) { notify(trainerType) })); err != nil {
+			return fmt.Errorf("failed to watch %v: %v", resource.Resource, err)
+		}
+	}
+	factory.Start(ctx.Done())
+	return nil
+}
+
+// notifyHandler calls notify with the added, updated or deleted object
+func notifyHandler(notify func(obj interface{})) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { notify(obj) },
+		UpdateFunc: func(oldObj, newObj interface{}) { notify(newObj) },
+		DeleteFunc: func(obj interface{}) { notify(obj) },
+	}
+}
+
+func inWatchNamespace(options watchOptions, namespace string) bool {
+	return options.namespace == metav1.NamespaceAll || options.namespace == namespace
+}
+
+// watchPrinter prints the refreshed output, it redraws the output in place on a terminal and
+// only prints the changed lines otherwise, the lines are compared without the values of watchTimeColumns
+type watchPrinter struct {
+	out      io.Writer
+	terminal bool
+	printed  map[string]bool
+}
+
+func newWatchPrinter(out io.Writer, terminal bool) *watchPrinter {
+	return &watchPrinter{
+		out:      out,
+		terminal: terminal,
+		printed:  map[string]bool{},
+	}
+}
+
+// print prints the output whose columns are separated by tabs
+func (p *watchPrinter) print(output string) {
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	w := tabwriter.NewWriter(p.out, 0, 0, 2, ' ', 0)
+	if p.terminal {
+		fmt.Fprint(p.out, clearScreen)
+		for _, line := range lines {
+			fmt.Fprintln(w, line)
+		}
+	} else {
+		printed := map[string]bool{}
+		// timeColumns are the indexes of the time columns of the current table with width columns
+		timeColumns := map[int]bool{}
+		width := 0
+		for _, line := range lines {
+			key := line
+			cells := strings.Split(line, "\t")
+			switch {
+			case strings.TrimSpace(line) == "":
+				timeColumns, width = map[int]bool{}, 0
+			case len(cells) == 2 && watchTimeColumns[strings.ToUpper(strings.TrimSuffix(strings.TrimSpace(cells[0]), ":"))]:
+				// a field like "Duration:" of the details
+				key = cells[0]
+			default:
+				if header := tableTimeColumns(cells); len(header) > 0 {
+					timeColumns, width = header, len(cells)
+				}
+				if len(cells) == width {
+					key = withoutColumns(cells, timeColumns)
+				}
+			}
+			if !p.printed[key] {
+				fmt.Fprintln(w, line)
+			}
+			printed[key] = true
+		}
+		p.printed = printed
+	}
+	_ = w.Flush()
+}
+
+// tableTimeColumns returns the indexes of the time columns if the cells are the header of a table
+func tableTimeColumns(cells []string) map[int]bool {
+	columns := map[int]bool{}
+	for i, cell := range cells {
+		if watchTimeColumns[strings.TrimSpace(cell)] {
+			columns[i] = true
+		}
+	}
+	return columns
+}
+
+func withoutColumns(cells []string, columns map[int]bool) string {
+	kept := []string{}
+	for i, cell := range cells {
+		if !columns[i] {
+			kept = append(kept, cell)
+		}
+	}
+	return strings.Join(kept, "\t")
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestWatchPrinter(t *testing.T) {
+	first := "NAME\tSTATUS\njob-a\tPENDING\njob-b\tRUNNING\n"
+	second := "NAME\tSTATUS\njob-a\tRUNNING\njob-b\tRUNNING\n"
+
+	var out bytes.Buffer
+	printer := newWatchPrinter(&out, false)
+	printer.print(first)
+	printer.print(second)
+	expected := "NAME   STATUS\njob-a  PENDING\njob-b  RUNNING\njob-a  RUNNING\n"
+	if out.String() != expected {
+		t.Errorf("expected only the changed rows to be printed:\n%q\ngot:\n%q", expected, out.String())
+	}
+
+	out.Reset()
+	printer = newWatchPrinter(&out, true)
+	printer.print(first)
+	printer.print(second)
+	expected = clearScreen + "NAME   STATUS\njob-a  PENDING\njob-b  RUNNING\n" + clearScreen + "NAME   STATUS\njob-a  RUNNING\njob-b  RUNNING\n"
+	if out.String() != expected {
+		t.Errorf("expected the output to be redrawn:\n%q\ngot:\n%q", expected, out.String())
+	}
+}
+
+func TestWatchPrinterTimeColumns(t *testing.T) {
+	first := "NAME\tSTATUS\tDURATION\njob-a\tRUNNING\t10\n\nName:\tjob-a\nDuration:\t10s\n\nInstances:\n  NAME\tAGE\tNODE\n  pod-0\t10s\tnode-1\n"
+	second := "NAME\tSTATUS\tDURATION\njob-a\tRUNNING\t12\n\nName:\tjob-a\nDuration:\t12s\n\nInstances:\n  NAME\tAGE\tNODE\n  pod-0\t12s\tnode-2\n"
+
+	var out bytes.Buffer
+	printer := newWatchPrinter(&out, false)
+	printer.print(first)
+	out.Reset()
+	printer.print(second)
+	expected := "  pod-0  12s  node-2\n"
+	if out.String() != expected {
+		t.Errorf("expected the rows only changed in the time columns to be skipped:\n%q\ngot:\n%q", expected, out.String())
+	}
+}
+
+func TestChangeNotifier(t *testing.T) {
+	notifier := newChangeNotifier()
+	notifier.notify(types.PytorchTrainingJob)
+	select {
+	case <-notifier.signal:
+	default:
+		t.Fatalf("expected the change to be signaled")
+	}
+	notifier.notify(types.TFTrainingJob)
+	changes := notifier.take()
+	if changes.all || !changes.has(types.PytorchTrainingJob) || !changes.has(types.TFTrainingJob) || changes.has(types.MPITrainingJob) {
+		t.Errorf("unexpected changes %+v", changes)
+	}
+	select {
+	case <-notifier.signal:
+		t.Errorf("expected the signal to be taken with the changes")
+	default:
+	}
+	notifier.notify("")
+	if changes := notifier.take(); !changes.has(types.MPITrainingJob) {
+		t.Errorf("expected an unknown trainer to change all the trainers, got %+v", changes)
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+)
+
+// FieldManager is the field manager of the server side apply of arena
+const FieldManager = "arena"
+
+const (
+	ActionCreated    = "created"
+	ActionConfigured = "configured"
+	ActionDeleted    = "deleted"
+	ActionNotFound   = "not found"
+	ActionPatched    = "patched"
+	ActionFailed     = "failed"
+	ActionRolledBack = "rolled back"
+)
+
+// ErrRollbackIncomplete means some objects created by a failed apply are not deleted
+var ErrRollbackIncomplete = errors.New("failed to roll back the created resources, please delete them manually")
+
+var crdResource = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// ObjectResult is the result of applying, deleting or patching an object
+type ObjectResult struct {
+	// Resource is the reference of the object saved in the app info, like pytorchjob.kubeflow.org/my-job
+	Resource string
+	// GroupVersionKind is the resolved kind of the object
+	GroupVersionKind schema.GroupVersionKind
+	// Namespace is empty for cluster scoped objects
+	Namespace string
+	Name      string
+	// Action is one of created, configured, deleted, not found, patched, failed or rolled back
+	Action string
+	// DryRun is true if nothing is persisted by the server
+	DryRun bool
+	Error  error
+}
+
+func (r ObjectResult) String() string {
+	if r.Error != nil {
+		return fmt.Sprintf("%s %s: %v", r.Resource, r.Action, r.Error)
+	}
+	if r.DryRun {
+		return fmt.Sprintf("%s %s (server dry run)", r.Resource, r.Action)
+	}
+	return fmt.Sprintf("%s %s", r.Resource, r.Action)
+}
+
+// Applier creates, deletes and patches the objects of the rendered manifests with a dynamic client,
+// the kinds and resource references are resolved by the rest mapper
+type Applier struct {
+	client       dynamic.Interface
+	mapper       meta.RESTMapper
+	discovery    discovery.DiscoveryInterface
+	fieldManager string
+}
+
+// NewApplier returns an applier with the given clients, it is used by the tests with the fake clients
+func NewApplier(client dynamic.Interface, mapper meta.RESTMapper, discoveryClient discovery.DiscoveryInterface) *Applier {
+	return &Applier{
+		client:       client,
+		mapper:       mapper,
+		discovery:    discoveryClient,
+		fieldManager: FieldManager,
+	}
+}
+
+// newDefaultApplier returns an applier of the cluster of the arena config, the rest mapper is backed by a cached discovery
+func newDefaultApplier() (*Applier, error) {
+	restConfig := config.GetArenaConfiger().GetRestConfig()
+	client, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	cachedClient := memory.NewMemCacheClient(discoveryClient)
+	return NewApplier(client, restmapper.NewDeferredDiscoveryRESTMapper(cachedClient), cachedClient), nil
+}
+
+// DecodeManifests decodes the objects of the multi-document yaml, the empty documents are skipped
+func DecodeManifests(data []byte) ([]*unstructured.Unstructured, error) {
+	objects := []*unstructured.Unstructured{}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode manifests: %v", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("invalid object in manifests, kind and metadata.name are required: %v", obj.Object)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// ResourceReference returns the reference of the object like kubectl, e.g. service/my-job or pytorchjob.kubeflow.org/my-job
+func ResourceReference(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	resource := strings.ToLower(gvk.Kind)
+	if gvk.Group != "" {
+		resource = resource + "." + gvk.Group
+	}
+	return resource + "/" + obj.GetName()
+}
+
+// Apply creates or updates the objects by server side apply, the objects without namespace are put in
+// the given namespace. It returns the result of each object and the aggregated error of the failed ones
+func (a *Applier) Apply(ctx context.Context, objects []*unstructured.Unstructured, namespace string, dryRun bool) ([]ObjectResult, error) {
+	results := []ObjectResult{}
+	errs := []error{}
+	for _, obj := range objects {
+		result := a.apply(ctx, obj.DeepCopy(), namespace, dryRun)
+		if result.Error != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", result.Resource, result.Error))
+		}
+		log.Debugf("apply %v", result)
+		results = append(results, result)
+	}
+	return results, utilerrors.NewAggregate(errs)
+}
+
+// ApplyAtomic applies the objects in order like Apply but stops at the first failure, then the objects created
+// by it are deleted in reverse order so that no partially created resources are left behind. The action of the
+// deleted ones becomes rolled back, and the returned error wraps ErrRollbackIncomplete if some are not deleted
+func (a *Applier) ApplyAtomic(ctx context.Context, objects []*unstructured.Unstructured, namespace string) ([]ObjectResult, error) {
+	results := []ObjectResult{}
+	for _, obj := range objects {
+		result := a.apply(ctx, obj.DeepCopy(), namespace, false)
+		log.Debugf("apply %v", result)
+		results = append(results, result)
+		if result.Error == nil {
+			continue
+		}
+		applyErr := fmt.Errorf("%s: %v", result.Resource, result.Error)
+		errs := []error{}
+		for i := len(results) - 1; i >= 0; i-- {
+			switch results[i].Action {
+			case ActionCreated:
+				deleted := a.delete(ctx, results[i].Resource, results[i].Namespace)
+				if deleted.Error != nil {
+					errs = append(errs, fmt.Errorf("%s: %v", deleted.Resource, deleted.Error))
+					continue
+				}
+				results[i].Action = ActionRolledBack
+			case ActionConfigured:
+				log.Warnf("%s existed before and is not rolled back", results[i].Resource)
+			}
+		}
+		if err := utilerrors.NewAggregate(errs); err != nil {
+			return results, fmt.Errorf("%v, %w: %v", applyErr, ErrRollbackIncomplete, err)
+		}
+		return results, applyErr
+	}
+	return results, nil
+}
+
+func (a *Applier) apply(ctx context.Context, obj *unstructured.Unstructured, namespace string, dryRun bool) ObjectResult {
+	result := ObjectResult{
+		Resource:         ResourceReference(obj),
+		GroupVersionKind: obj.GroupVersionKind(),
+		Name:             obj.GetName(),
+		DryRun:           dryRun,
+		Action:           ActionFailed,
+	}
+	gvk := obj.GroupVersionKind()
+	mapping, err := a.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		result.Error = err
+		return result
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace && obj.GetNamespace() == "" {
+		obj.SetNamespace(namespace)
+	}
+	result.Namespace = obj.GetNamespace()
+	client := a.resourceClient(mapping, result.Namespace)
+
+	result.Action = ActionConfigured
+	if _, err := client.Get(ctx, obj.GetName(), metav1.GetOptions{}); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			result.Action, result.Error = ActionFailed, err
+			return result
+		}
+		result.Action = ActionCreated
+	}
+	options := metav1.ApplyOptions{FieldManager: a.fieldManager, Force: true}
+	if dryRun {
+		options.DryRun = []string{metav1.DryRunAll}
+	}
+	if _, err := client.Apply(ctx, obj.GetName(), obj, options); err != nil {
+		result.Action, result.Error = ActionFailed, err
+	}
+	return result
+}
+
+// Delete deletes the objects of the resource references, the objects not found are not treated as errors
+func (a *Applier) Delete(ctx context.Context, resources []string, namespace string) ([]ObjectResult, error) {
+	results := []ObjectResult{}
+	errs := []error{}
+	for _, resource := range resources {
+		result := a.delete(ctx, resource, namespace)
+		if result.Error != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", resource, result.Error))
+		}
+		results = append(results, result)
+	}
+	return results, utilerrors.NewAggregate(errs)
+}
+
+func (a *Applier) delete(ctx context.Context, resource, namespace string) ObjectResult {
+	result := ObjectResult{Resource: resource, Namespace: namespace, Action: ActionDeleted}
+	propagation := metav1.DeletePropagationBackground
+	mapping, name, err := a.mappingOf(resource)
+	if err == nil {
+		result.Name, result.GroupVersionKind = name, mapping.GroupVersionKind
+		if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+			result.Namespace = ""
+		}
+		err = a.resourceClient(mapping, result.Namespace).Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	}
+	switch {
+	case err == nil:
+	case k8serrors.IsNotFound(err) || meta.IsNoMatchError(err):
+		result.Action = ActionNotFound
+	default:
+		result.Action, result.Error = ActionFailed, err
+	}
+	log.Debugf("delete %v", result)
+	return result
+}
+
+// DiscoverByLabels returns the references of the objects in the namespace matching the label selector, all the
+// namespaced resources which can be listed and deleted are searched. The objects owned by a controller are
+// skipped since the garbage collector deletes them with their owners
+func (a *Applier) DiscoverByLabels(ctx context.Context, namespace, selector string) ([]string, error) {
+	if a.discovery == nil {
+		return nil, fmt.Errorf("the discovery client is not set")
+	}
+	_, lists, err := a.discovery.ServerGroupsAndResources()
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) || len(lists) == 0 {
+			return nil, err
+		}
+		log.Debugf("some groups are not discovered: %v", err)
+	}
+	references := []string{}
+	searched := map[schema.GroupResource]bool{}
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range list.APIResources {
+			gr := schema.GroupResource{Group: gv.Group, Resource: resource.Name}
+			if !resource.Namespaced || strings.Contains(resource.Name, "/") || searched[gr] ||
+				!hasVerbs(resource.Verbs, "list", "delete") {
+				continue
+			}
+			searched[gr] = true
+			objects, err := a.client.Resource(gv.WithResource(resource.Name)).Namespace(namespace).
+				List(ctx, metav1.ListOptions{LabelSelector: selector})
+			if err != nil {
+				log.Debugf("failed to list %s: %v", gr, err)
+				continue
+			}
+			for i := range objects.Items {
+				if metav1.GetControllerOf(&objects.Items[i]) != nil {
+					continue
+				}
+				references = append(references, ResourceReference(&objects.Items[i]))
+			}
+		}
+	}
+	return references, nil
+}
+
+// Patch patches the object of the resource reference
+func (a *Applier) Patch(ctx context.Context, resource, namespace string, patchType k8stypes.PatchType, data []byte) (ObjectResult, error) {
+	result := ObjectResult{Resource: resource, Namespace: namespace, Action: ActionFailed}
+	mapping, name, err := a.mappingOf(resource)
+	if err != nil {
+		result.Error = err
+		return result, err
+	}
+	result.Name, result.GroupVersionKind = name, mapping.GroupVersionKind
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		result.Namespace = ""
+	}
+	if _, err := a.resourceClient(mapping, result.Namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{FieldManager: a.fieldManager}); err != nil {
+		result.Error = err
+		return result, err
+	}
+	result.Action = ActionPatched
+	return result, nil
+}
+
+// Get returns the object of the resource reference
+func (a *Applier) Get(ctx context.Context, resource, namespace string) (*unstructured.Unstructured, error) {
+	mapping, name, err := a.mappingOf(resource)
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		namespace = ""
+	}
+	return a.resourceClient(mapping, namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// CRDNames returns the names of the custom resource definitions of the cluster
+func (a *Applier) CRDNames(ctx context.Context) ([]string, error) {
+	list, err := a.client.Resource(crdResource).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+	}
+	return names, nil
+}
+
+// mappingOf resolves the resource reference like kubectl, the resource can be a kind, a singular or a plural
+// name optionally followed by the group, e.g. service/my-job, tfjobs.kubeflow.org/my-job
+func (a *Applier) mappingOf(reference string) (*meta.RESTMapping, string, error) {
+	resource, name, found := strings.Cut(strings.TrimSpace(reference), "/")
+	if !found || resource == "" || name == "" {
+		return nil, "", fmt.Errorf("invalid resource reference %q, it should be <resource>/<name>", reference)
+	}
+	gvr := schema.GroupVersionResource{Resource: resource}
+	if i := strings.Index(resource, "."); i > 0 {
+		gvr.Resource, gvr.Group = resource[:i], resource[i+1:]
+	}
+	gvk, err := a.mapper.KindFor(gvr)
+	if err != nil {
+		return nil, "", err
+	}
+	mapping, err := a.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, "", err
+	}
+	return mapping, name, nil
+}
+
+func (a *Applier) resourceClient(mapping *meta.RESTMapping, namespace string) dynamic.ResourceInterface {
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return a.client.Resource(mapping.Resource).Namespace(namespace)
+	}
+	return a.client.Resource(mapping.Resource)
+}
+
+func hasVerbs(verbs metav1.Verbs, required ...string) bool {
+	for _, verb := range required {
+		found := false
+		for _, v := range verbs {
+			if v == verb {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// formatResults formats the results like the output of kubectl, one object per line
+func formatResults(results []ObjectResult) string {
+	lines := []string{}
+	for _, result := range results {
+		lines = append(lines, result.String())
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,317 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubectl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const testManifests = `---
+# Source: pytorchjob/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: test-svc
+spec:
+  ports:
+  - port: 80
+---
+---
+apiVersion: kubeflow.org/v1
+kind: PyTorchJob
+metadata:
+  name: test
+  namespace: team-a
+spec:
+  pytorchReplicaSpecs: {}
+---
+apiVersion: scheduling.k8s.io/v1
+kind: PriorityClass
+metadata:
+  name: test-priority
+value: 100
+`
+
+var (
+	serviceGVK       = schema.GroupVersionKind{Version: "v1", Kind: "Service"}
+	podGVK           = schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	pytorchJobGVK    = schema.GroupVersionKind{Group: "kubeflow.org", Version: "v1", Kind: "PyTorchJob"}
+	priorityClassGVK = schema.GroupVersionKind{Group: "scheduling.k8s.io", Version: "v1", Kind: "PriorityClass"}
+	crdGVK           = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+)
+
+// newTestApplier returns an applier of a fake dynamic client, the fake client does not support server side
+// apply of unstructured objects, so a reactor creates or replaces the objects with the applied ones
+func newTestApplier(objects ...runtime.Object) (*Applier, *dynamicfake.FakeDynamicClient) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(serviceGVK, meta.RESTScopeNamespace)
+	mapper.Add(podGVK, meta.RESTScopeNamespace)
+	mapper.Add(pytorchJobGVK, meta.RESTScopeNamespace)
+	mapper.Add(priorityClassGVK, meta.RESTScopeRoot)
+	mapper.Add(crdGVK, meta.RESTScopeRoot)
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		crdResource:                           "CustomResourceDefinitionList",
+		{Version: "v1", Resource: "services"}: "ServiceList",
+		{Version: "v1", Resource: "pods"}:     "PodList",
+		{Group: "kubeflow.org", Version: "v1", Resource: "pytorchjobs"}:          "PyTorchJobList",
+		{Group: "scheduling.k8s.io", Version: "v1", Resource: "priorityclasses"}: "PriorityClassList",
+	}, objects...)
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "services", Kind: "Service", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "delete"}},
+				{Name: "services/status", Kind: "Service", Namespaced: true, Verbs: metav1.Verbs{"get", "patch"}},
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "delete"}},
+			},
+		},
+		{
+			GroupVersion: "kubeflow.org/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pytorchjobs", Kind: "PyTorchJob", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "delete"}},
+			},
+		},
+		{
+			GroupVersion: "scheduling.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "priorityclasses", Kind: "PriorityClass", Verbs: metav1.Verbs{"get", "list", "delete"}},
+			},
+		},
+	}}}
+	client.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchAction)
+		if patch.GetPatchType() != k8stypes.ApplyPatchType {
+			return false, nil, nil
+		}
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(patch.GetPatch(), &obj.Object); err != nil {
+			return true, nil, err
+		}
+		_, err := client.Tracker().Get(patch.GetResource(), patch.GetNamespace(), patch.GetName())
+		if k8serrors.IsNotFound(err) {
+			return true, obj, client.Tracker().Create(patch.GetResource(), obj, patch.GetNamespace())
+		}
+		return true, obj, client.Tracker().Update(patch.GetResource(), obj, patch.GetNamespace())
+	})
+	return NewApplier(client, mapper, discoveryClient), client
+}
+
+func TestDecodeManifests(t *testing.T) {
+	objects, err := DecodeManifests([]byte(testManifests))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"service/test-svc", "pytorchjob.kubeflow.org/test", "priorityclass.scheduling.k8s.io/test-priority"}
+	if len(objects) != len(expected) {
+		t.Fatalf("expected %d objects, got %d", len(expected), len(objects))
+	}
+	for i, obj := range objects {
+		if reference := ResourceReference(obj); reference != expected[i] {
+			t.Errorf("expected reference %s, got %s", expected[i], reference)
+		}
+	}
+
+	if _, err := DecodeManifests([]byte("apiVersion: v1\nkind: Service\n")); err == nil {
+		t.Errorf("expected an error of an object without name")
+	}
+}
+
+func TestApplierApply(t *testing.T) {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(serviceGVK)
+	existing.SetName("test-svc")
+	existing.SetNamespace("default")
+	applier, client := newTestApplier(existing)
+
+	objects, err := DecodeManifests([]byte(testManifests))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	results, err := applier.Apply(context.TODO(), objects, "default", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []ObjectResult{
+		{Resource: "service/test-svc", Namespace: "default", Action: ActionConfigured},
+		{Resource: "pytorchjob.kubeflow.org/test", Namespace: "team-a", Action: ActionCreated},
+		{Resource: "priorityclass.scheduling.k8s.io/test-priority", Namespace: "", Action: ActionCreated},
+	}
+	for i, result := range results {
+		if result.Resource != expected[i].Resource || result.Namespace != expected[i].Namespace || result.Action != expected[i].Action {
+			t.Errorf("expected result %v, got %v", expected[i], result)
+		}
+	}
+
+	job, err := client.Resource(schema.GroupVersionResource{Group: "kubeflow.org", Version: "v1", Resource: "pytorchjobs"}).
+		Namespace("team-a").Get(context.TODO(), "test", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("the pytorchjob is not created: %v", err)
+	}
+	if job.GetName() != "test" {
+		t.Errorf("expected pytorchjob test, got %s", job.GetName())
+	}
+	for _, action := range client.Actions() {
+		patch, ok := action.(k8stesting.PatchAction)
+		if ok && patch.GetPatchType() != k8stypes.ApplyPatchType {
+			t.Errorf("expected server side apply, got patch type %s", patch.GetPatchType())
+		}
+	}
+
+	unknown := &unstructured.Unstructured{}
+	unknown.SetAPIVersion("example.com/v1")
+	unknown.SetKind("Unknown")
+	unknown.SetName("test")
+	if _, err := applier.Apply(context.TODO(), []*unstructured.Unstructured{unknown}, "default", false); err == nil {
+		t.Errorf("expected an error of an unknown kind")
+	}
+}
+
+func TestApplierDelete(t *testing.T) {
+	job := &unstructured.Unstructured{}
+	job.SetGroupVersionKind(pytorchJobGVK)
+	job.SetName("test")
+	job.SetNamespace("default")
+	applier, client := newTestApplier(job)
+
+	results, err := applier.Delete(context.TODO(), []string{"pytorchjob.kubeflow.org/test", "service/test-svc", "pytorchjobs.kubeflow.org/test"}, "default")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{ActionDeleted, ActionNotFound, ActionNotFound}
+	for i, result := range results {
+		if result.Action != expected[i] {
+			t.Errorf("expected %s %s, got %s", result.Resource, expected[i], result.Action)
+		}
+	}
+	_, err = client.Resource(schema.GroupVersionResource{Group: "kubeflow.org", Version: "v1", Resource: "pytorchjobs"}).
+		Namespace("default").Get(context.TODO(), "test", metav1.GetOptions{})
+	if !k8serrors.IsNotFound(err) {
+		t.Errorf("expected the pytorchjob to be deleted, got %v", err)
+	}
+
+	if _, err := applier.Delete(context.TODO(), []string{"test"}, "default"); err == nil {
+		t.Errorf("expected an error of an invalid reference")
+	}
+}
+
+func TestApplierCRDNames(t *testing.T) {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(crdGVK)
+	crd.SetName("pytorchjobs.kubeflow.org")
+	applier, _ := newTestApplier(crd)
+
+	names, err := applier.CRDNames(context.TODO())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(names) != 1 || names[0] != "pytorchjobs.kubeflow.org" {
+		t.Errorf("expected crd pytorchjobs.kubeflow.org, got %v", names)
+	}
+}
+
+func TestApplierApplyAtomic(t *testing.T) {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(serviceGVK)
+	existing.SetName("test-svc")
+	existing.SetNamespace("default")
+	applier, client := newTestApplier(existing)
+	client.PrependReactor("patch", "priorityclasses", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(schema.GroupResource{Group: "scheduling.k8s.io", Resource: "priorityclasses"}, "test-priority", errors.New("denied"))
+	})
+
+	objects, err := DecodeManifests([]byte(testManifests))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	results, err := applier.ApplyAtomic(context.TODO(), objects, "default")
+	if err == nil {
+		t.Fatalf("expected an error of the forbidden priority class")
+	}
+	if errors.Is(err, ErrRollbackIncomplete) {
+		t.Errorf("expected the rollback to be complete, got %v", err)
+	}
+	expected := []string{ActionConfigured, ActionRolledBack, ActionFailed}
+	for i, result := range results {
+		if result.Action != expected[i] {
+			t.Errorf("expected %s %s, got %s", result.Resource, expected[i], result.Action)
+		}
+	}
+	_, err = client.Resource(schema.GroupVersionResource{Group: "kubeflow.org", Version: "v1", Resource: "pytorchjobs"}).
+		Namespace("team-a").Get(context.TODO(), "test", metav1.GetOptions{})
+	if !k8serrors.IsNotFound(err) {
+		t.Errorf("expected the created pytorchjob to be rolled back, got %v", err)
+	}
+	_, err = client.Resource(schema.GroupVersionResource{Version: "v1", Resource: "services"}).
+		Namespace("default").Get(context.TODO(), "test-svc", metav1.GetOptions{})
+	if err != nil {
+		t.Errorf("expected the existing service to be kept, got %v", err)
+	}
+
+	client.PrependReactor("delete", "pytorchjobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	if _, err := applier.ApplyAtomic(context.TODO(), objects, "default"); !errors.Is(err, ErrRollbackIncomplete) {
+		t.Errorf("expected an incomplete rollback, got %v", err)
+	}
+}
+
+func TestApplierDiscoverByLabels(t *testing.T) {
+	newObject := func(gvk schema.GroupVersionKind, name string, labels map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetName(name)
+		obj.SetNamespace("default")
+		obj.SetLabels(labels)
+		return obj
+	}
+	labels := map[string]string{"app": "pytorchjob", "release": "test"}
+	owned := newObject(podGVK, "test-master-0", labels)
+	controller := true
+	owned.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "kubeflow.org/v1", Kind: "PyTorchJob", Name: "test", Controller: &controller}})
+	applier, _ := newTestApplier(
+		newObject(pytorchJobGVK, "test", labels),
+		newObject(serviceGVK, "test-svc", labels),
+		newObject(serviceGVK, "other-svc", map[string]string{"app": "pytorchjob", "release": "other"}),
+		owned,
+	)
+
+	references, err := applier.DiscoverByLabels(context.TODO(), "default", "app=pytorchjob,release=test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]bool{"service/test-svc": true, "pytorchjob.kubeflow.org/test": true}
+	if len(references) != len(expected) {
+		t.Fatalf("expected references %v, got %v", expected, references)
+	}
+	for _, reference := range references {
+		if !expected[reference] {
+			t.Errorf("unexpected reference %s", reference)
+		}
+	}
+}
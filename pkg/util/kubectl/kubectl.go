@@ -19,50 +19,48 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
-	"sync"
 
 	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	kserveClient "github.com/kserve/kserve/pkg/client/clientset/versioned"
 	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
 	lwsClient "sigs.k8s.io/lws/client-go/clientset/versioned"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
 )
 
-var kubectlCmd = []string{"arena-kubectl"}
-
 /**
-* dry-run creating kubernetes App Info for delete in future
-* Exec /usr/local/bin/kubectl, [create --dry-run -f /tmp/values313606961 --namespace default]
+* save the references of the objects of the manifests for delete in future
+* the references are like kubectl, e.g. service/my-job, pytorchjob.kubeflow.org/my-job
 **/
 
 func SaveAppInfo(fileName, namespace string) (configFileName string, err error) {
-	if _, err = os.Stat(fileName); os.IsNotExist(err) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
 		return "", err
 	}
-
-	args := []string{"create", "--dry-run=client", "--namespace", namespace, "-f", fileName}
-	out, err := kubectl(args)
-	output := string(out)
-	result := []string{}
-
-	// fmt.Printf("%s\n", string(out))
+	objects, err := DecodeManifests(data)
 	if err != nil {
-		log.Errorf("Failed to execute %s, %v with %v", "kubectl", args, err)
-		log.Errorf("The output is %s\n", output)
+		log.Errorf("Failed to decode the manifests %s: %v", fileName, err)
 		return "", err
 	}
+	result := []string{}
+	for _, obj := range objects {
+		result = append(result, ResourceReference(obj))
+	}
+	log.Debugf("app info: %v", result)
 
 	// 1. generate the config file
 	configFile, err := os.CreateTemp("", "config")
 	if err != nil {
-		log.Errorf("Failed to create tmp file %v due to %v", configFile.Name(), err)
+		log.Errorf("Failed to create tmp file due to %v", err)
 		return "", err
 	}
 
@@ -70,24 +68,11 @@ func SaveAppInfo(fileName, namespace string) (configFileName string, err error)
 	log.Debugf("Save the config file %s", configFileName)
 
 	// 2. save app types to config file
-	lines := strings.Split(output, "\n")
-	log.Debugf("dry run result: %v", lines)
-
-	for _, line := range lines {
-		line := strings.TrimSpace(line)
-		cols := strings.Fields(line)
-		log.Debugf("cols: %s, %d", cols, len(cols))
-		if len(cols) == 0 {
-			continue
-		}
-		result = append(result, cols[0])
-	}
-
-	data := []byte(strings.Join(result, "\n"))
+	content := []byte(strings.Join(result, "\n"))
 	defer configFile.Close()
-	_, err = configFile.Write(data)
+	_, err = configFile.Write(content)
 	if err != nil {
-		log.Errorf("Failed to write %v to %s due to %v", data, configFileName, err)
+		log.Errorf("Failed to write %v to %s due to %v", content, configFileName, err)
 		return configFileName, err
 	}
 
@@ -95,91 +80,129 @@ func SaveAppInfo(fileName, namespace string) (configFileName string, err error)
 }
 
 /**
-* Delete kubernetes config to uninstall app
-* Exec /usr/local/bin/kubectl, [delete -f /tmp/values313606961 --namespace default]
+* Delete the objects of the manifests to uninstall app
 **/
 func UninstallApps(fileName, namespace string) (err error) {
-	if _, err = os.Stat(fileName); os.IsNotExist(err) {
+	objects, applier, err := loadManifests(fileName)
+	if err != nil {
 		return err
 	}
-
-	args := []string{"delete", "--namespace", namespace, "-f", fileName}
-	out, err := kubectl(args)
-
-	fmt.Printf("%s\n", string(out))
+	resources := []string{}
+	for _, obj := range objects {
+		resources = append(resources, ResourceReference(obj))
+	}
+	results, err := applier.Delete(context.TODO(), resources, namespace)
+	fmt.Printf("%s", formatResults(results))
 	if err != nil {
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
+		log.Debugf("Failed to uninstall apps of %s: %v", fileName, err)
 	}
 
 	return err
 }
 
 /**
-* Delete kubernetes config to uninstall app
-* Exec /usr/local/bin/kubectl, [delete -f /tmp/values313606961 --namespace default]
+* Delete the objects saved in the app info file to uninstall app
 **/
 func UninstallAppsWithAppInfoFile(appInfoFile, namespace string) error {
-	binary, err := exec.LookPath(kubectlCmd[0])
+	data, err := os.ReadFile(appInfoFile)
 	if err != nil {
 		return err
 	}
-
-	data, err := os.ReadFile(appInfoFile)
+	resources := []string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			resources = append(resources, line)
+		}
+	}
+	applier, err := newDefaultApplier()
 	if err != nil {
 		return err
 	}
-	resources := strings.Split(string(data), "\n")
-	// Error from server (NotFound): tfjobs.kubeflow.org "tf-standalone-test-3" not found
-
-	var wg sync.WaitGroup
-	locker := new(sync.RWMutex)
-	errs := []string{}
-	for _, r := range resources {
-		wg.Add(1)
-		resource := r
-		go func() {
-			defer wg.Done()
-			args := []string{binary, "delete", resource, "--namespace", namespace}
-			log.Debugf("Exec bash -c %v", args)
-			cmd := exec.Command("bash", "-c", strings.Join(args, " "))
-			out, err := cmd.CombinedOutput()
-			if err != nil {
-				if !strings.Contains(string(out), "Error from server (NotFound): ") {
-					locker.Lock()
-					errs = append(errs, string(out))
-					locker.Unlock()
-				}
-				return
-			}
-			log.Debugf("%v", out)
-		}()
-	}
-	wg.Wait()
-	if len(errs) != 0 {
-		log.Debugf("Failed to uninstall app with app file,reason: %v", errs)
-		return fmt.Errorf("%v", strings.Join(errs, "\n"))
+	if _, err := applier.Delete(context.TODO(), resources, namespace); err != nil {
+		log.Debugf("Failed to uninstall app with app file,reason: %v", err)
+		return err
 	}
 	return nil
 }
 
 /**
-* Apply kubernetes config to install app
-* Exec /usr/local/bin/kubectl, [apply -f /tmp/values313606961 --namespace default]
+* Apply the objects of the manifests to install app by server side apply
+* the installation is atomic, the created objects are rolled back if any object fails
 **/
 func InstallApps(fileName, namespace string) (output string, err error) {
-	if _, err = os.Stat(fileName); os.IsNotExist(err) {
-		return output, err
+	objects, applier, err := loadManifests(fileName)
+	if err != nil {
+		return "", err
+	}
+	results, err := applier.ApplyAtomic(context.TODO(), objects, namespace)
+	output = formatResults(results)
+
+	log.Debugf("%s", output)
+	if err != nil {
+		log.Debugf("Failed to install apps of %s: %v", fileName, err)
 	}
 
-	args := []string{"apply", "--namespace", namespace, "-f", fileName}
-	out, err := kubectl(args)
+	return output, err
+}
+
+// DryRunApps validates the manifests of the file by a server side dry run, nothing is persisted
+func DryRunApps(fileName, namespace string) (output string, err error) {
+	objects, applier, err := loadManifests(fileName)
+	if err != nil {
+		return "", err
+	}
+	results, err := applier.Apply(context.TODO(), objects, namespace, true)
+	output = formatResults(results)
 
-	log.Debugf("%s", string(out))
+	log.Debugf("%s", output)
 	if err != nil {
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
+		log.Debugf("Failed to dry run apps of %s: %v", fileName, err)
 	}
 
-	return string(out), err
+	return output, err
+}
+
+// loadManifests decodes the objects of the manifests file and returns the applier of them
+func loadManifests(fileName string) ([]*unstructured.Unstructured, *Applier, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+	objects, err := DecodeManifests(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	applier, err := newDefaultApplier()
+	if err != nil {
+		return nil, nil, err
+	}
+	return objects, applier, nil
+}
+
+/**
+* Delete the objects matching the label selector to uninstall app, it is used when the app info is lost
+* it returns the references of the deleted objects
+**/
+func UninstallAppsWithLabels(selector, namespace string) ([]string, error) {
+	applier, err := newDefaultApplier()
+	if err != nil {
+		return nil, err
+	}
+	resources, err := applier.DiscoverByLabels(context.TODO(), namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	results, err := applier.Delete(context.TODO(), resources, namespace)
+	deleted := []string{}
+	for _, result := range results {
+		if result.Action == ActionDeleted {
+			deleted = append(deleted, result.Resource)
+		}
+	}
+	if err != nil {
+		log.Debugf("Failed to uninstall app with labels %s,reason: %v", selector, err)
+	}
+	return deleted, err
 }
 
 /**
@@ -187,40 +210,48 @@ func InstallApps(fileName, namespace string) (output string, err error) {
 * create configMap by using name, namespace and configFile
 **/
 func CreateAppConfigmap(name, trainingType, namespace, configFileName, appInfoFileName, chartName, chartVersion string) (err error) {
-	if _, err = os.Stat(configFileName); os.IsNotExist(err) {
+	values, err := os.ReadFile(configFileName)
+	if err != nil {
 		return err
 	}
-
-	if _, err = os.Stat(appInfoFileName); os.IsNotExist(err) {
+	appInfo, err := os.ReadFile(appInfoFileName)
+	if err != nil {
 		return err
 	}
-
-	args := []string{"create", "configmap", fmt.Sprintf("%s-%s", name, trainingType),
-		"--namespace", namespace,
-		fmt.Sprintf("--from-file=%s=%s", "values", configFileName),
-		fmt.Sprintf("--from-file=%s=%s", "app", appInfoFileName),
-		fmt.Sprintf("--from-literal=%s=%s", chartName, chartVersion)}
-	// "--overrides='{\"metadata\":{\"label\":\"createdBy\": \"arena\"}}'"}
-	out, err := kubectl(args)
-
-	fmt.Printf("%s", string(out))
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", name, trainingType),
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			"values":  string(values),
+			"app":     string(appInfo),
+			chartName: chartVersion,
+		},
+	}
+	client := config.GetArenaConfiger().GetClientSet()
+	_, err = client.CoreV1().ConfigMaps(namespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
 	if err != nil {
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
+		log.Debugf("Failed to create configmap %s: %v", configMap.Name, err)
 	}
 
 	return err
 }
 
 func LabelAppConfigmap(name, trainingType, namespace, label string) (err error) {
-	args := []string{"label", "configmap", fmt.Sprintf("%s-%s", name, trainingType),
-		"--namespace", namespace,
-		label}
-	// "--overrides='{\"metadata\":{\"label\":\"createdBy\": \"arena\"}}'"}
-	out, err := kubectl(args)
-
-	fmt.Printf("%s", string(out))
+	key, value, _ := strings.Cut(label, "=")
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]string{key: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	client := config.GetArenaConfiger().GetClientSet()
+	_, err = client.CoreV1().ConfigMaps(namespace).Patch(context.TODO(), fmt.Sprintf("%s-%s", name, trainingType), k8stypes.MergePatchType, patch, metav1.PatchOptions{})
 	if err != nil {
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
+		log.Debugf("Failed to label configmap %s-%s: %v", name, trainingType, err)
 	}
 
 	return err
@@ -231,16 +262,13 @@ func LabelAppConfigmap(name, trainingType, namespace, label string) (err error)
 * delete configMap by using name, namespace
 **/
 func DeleteAppConfigMap(name, namespace string) (err error) {
-	args := []string{"delete", "configmap", name, "--namespace", namespace}
-	out, err := kubectl(args)
-
-	if err != nil && !strings.Contains(string(out), "Error from server (NotFound): ") {
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
-		log.Debugf("%s", string(out))
+	client := config.GetArenaConfiger().GetClientSet()
+	err = client.CoreV1().ConfigMaps(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		log.Debugf("Failed to delete configmap %s: %v", name, err)
 		return err
-	} else {
-		log.Debugf("configmap %s has been deleted successfully", name)
 	}
+	log.Debugf("configmap %s has been deleted successfully", name)
 
 	return nil
 }
@@ -250,21 +278,14 @@ func DeleteAppConfigMap(name, namespace string) (err error) {
 * get configMap by using name, namespace
 **/
 func CheckAppConfigMap(name, namespace string) (found bool) {
-	args := []string{"get", "configmap", name, "--namespace", namespace}
-	out, err := kubectl(args)
-
+	client := config.GetArenaConfiger().GetClientSet()
+	_, err := client.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(`Error from server (NotFound): configmaps "%v" not found`, name)) {
-			found = false
-		}
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
-		log.Debugf("%s", string(out))
-	} else {
-		log.Debugf("%s", string(out))
-		found = true
+		log.Debugf("Failed to get configmap %s: %v", name, err)
+		return false
 	}
 
-	return found
+	return true
 }
 
 /**
@@ -272,77 +293,30 @@ func CheckAppConfigMap(name, namespace string) (found bool) {
 * save the key of configMap into a file
 **/
 func SaveAppConfigMapToFile(name, key, namespace string) (fileName string, err error) {
-	binary, err := exec.LookPath(kubectlCmd[0])
+	client := config.GetArenaConfiger().GetClientSet()
+	configMap, err := client.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("failed to get configmap %s: %w", name, err)
 	}
 
 	file, err := os.CreateTemp(os.TempDir(), name)
 	if err != nil {
-		log.Errorf("Failed to create tmp file %v due to %v", file.Name(), err)
+		log.Errorf("Failed to create tmp file due to %v", err)
 		return fileName, err
 	}
+	defer file.Close()
 	fileName = file.Name()
 
-	args := []string{binary, "get", "configmap", name,
-		"--namespace", namespace,
-		fmt.Sprintf("-o=jsonpath='{.data.%s}'", key),
-		">", fileName}
-	log.Debugf("Exec bash -c %s", strings.Join(args, " "))
-
-	cmd := exec.Command("bash", "-c", strings.Join(args, " "))
-	out, err := cmd.Output()
-	fmt.Printf("%s", string(out))
-
-	if err != nil {
-		return fileName, fmt.Errorf("failed to execute %s, %v with %v", "kubectl", args, err)
-	}
+	_, err = file.WriteString(configMap.Data[key])
 	return fileName, err
 }
 
-func kubectl(args []string) ([]byte, error) {
-	binary, err := exec.LookPath(kubectlCmd[0])
-	if err != nil {
-		return nil, err
-	}
-
-	// 1. prepare the arguments
-	// args := []string{"create", "configmap", name, "--namespace", namespace, fmt.Sprintf("--from-file=%s=%s", name, configFileName)}
-	log.Debugf("Exec %s, %v", binary, args)
-
-	env := os.Environ()
-
-	// return syscall.Exec(cmd, args, env)
-	// 2. execute the command
-	cmd := exec.Command(binary, args...)
-	cmd.Env = env
-	return cmd.CombinedOutput()
-}
-
 func GetCrdNames() ([]string, error) {
-	crdNames := []string{}
-	args := []string{"get", "crds"}
-	out, err := kubectl(args)
+	applier, err := newDefaultApplier()
 	if err != nil {
-		if strings.Contains(err.Error(), "No resources found.") {
-			return crdNames, nil
-		}
 		return nil, err
 	}
-	for _, line := range strings.Split(string(out), "\n") {
-		item := strings.Trim(line, " ")
-		if item == "" {
-			continue
-		}
-		if strings.Contains(item, "No resources found.") {
-			continue
-		}
-		if strings.Contains(item, "CREATED AT") {
-			continue
-		}
-		crdNames = append(crdNames, strings.Trim(strings.Split(item, " ")[0], " "))
-	}
-	return crdNames, nil
+	return applier.CRDNames(context.TODO())
 }
 
 func GetDeployment(name, namespace string) (*appsv1.Deployment, error) {
@@ -399,9 +373,9 @@ func PatchOwnerReferenceWithAppInfoFile(name, trainingType, appInfoFile, namespa
 		return nil
 	}
 
-	binary, err := exec.LookPath(kubectlCmd[0])
+	applier, err := newDefaultApplier()
 	if err != nil {
-		return fmt.Errorf("failed to locate kubectl binary: %v", err)
+		return err
 	}
 	errs := []string{}
 
@@ -412,21 +386,13 @@ func PatchOwnerReferenceWithAppInfoFile(name, trainingType, appInfoFile, namespa
 	}
 
 	// get training job
-	args := []string{binary, "get", resourceType, name, "--namespace", namespace, "-o json"}
-	cmd := exec.Command("bash", "-c", strings.Join(args, " "))
-	out, err := cmd.CombinedOutput()
+	obj, err := applier.Get(context.TODO(), resourceType+"/"+name, namespace)
 	if err != nil {
 		return fmt.Errorf("failed to get training job: %v", err)
 	}
 
-	obj := &unstructured.Unstructured{}
-	err = json.Unmarshal(out, obj)
-	if err != nil {
-		return fmt.Errorf("failed to unmarshal training job: %v", err)
-	}
-
-	patch := fmt.Sprintf(`-p='[{"op": "add", "path": "/metadata/ownerReferences", `+
-		`"value": [{"apiVersion": "%s","kind": "%s","name": "%s","uid": "%s","blockOwnerDeletion": true,"controller": true}]}]'`,
+	patch := fmt.Sprintf(`[{"op": "add", "path": "/metadata/ownerReferences", `+
+		`"value": [{"apiVersion": "%s","kind": "%s","name": "%s","uid": "%s","blockOwnerDeletion": true,"controller": true}]}]`,
 		obj.GetAPIVersion(), obj.GetKind(), name, obj.GetUID())
 
 	// add configmap
@@ -442,13 +408,10 @@ func PatchOwnerReferenceWithAppInfoFile(name, trainingType, appInfoFile, namespa
 		}
 
 		// patch ownerReferences
-		args := []string{binary, "patch", resource, "--namespace", namespace, "--type=json", patch}
-		log.Debugf("Exec bash -c %v", args)
-		cmd := exec.Command("bash", "-c", strings.Join(args, " "))
-		out, err := cmd.CombinedOutput()
-		log.Debugf("%s", string(out))
+		result, err := applier.Patch(context.TODO(), resource, namespace, k8stypes.JSONPatchType, []byte(patch))
+		log.Debugf("%v", result)
 		if err != nil {
-			errs = append(errs, string(out))
+			errs = append(errs, result.String())
 		}
 	}
 
@@ -15,7 +15,10 @@
 package kubectl
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/kubeflow/arena/pkg/apis/training"
 	"github.com/kubeflow/arena/pkg/apis/types"
@@ -25,121 +28,76 @@ func AddTrainingJobLabel(job *training.Job, key string, value string) error {
 	switch job.Type() {
 	case types.TFTrainingJob:
 		args := job.Args().(*types.SubmitTFJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"tfjobs.kubeflow.org",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource(args.Namespace, "tfjobs.kubeflow.org", args.Name, key, value)
 		if err != nil {
 			return err
 		}
 	case types.PytorchTrainingJob:
 		args := job.Args().(*types.SubmitPyTorchJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"pytorchjobs.kubeflow.org",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource(args.Namespace, "pytorchjobs.kubeflow.org", args.Name, key, value)
 		if err != nil {
 			return err
 		}
 	case types.MPITrainingJob:
 		args := job.Args().(*types.SubmitMPIJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"mpijobs.kubeflow.org",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource(args.Namespace, "mpijobs.kubeflow.org", args.Name, key, value)
 		if err != nil {
 			return err
 		}
 	case types.HorovodTrainingJob:
 		args := job.Args().(*types.SubmitHorovodJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"mpijobs.kubeflow.org",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource(args.Namespace, "mpijobs.kubeflow.org", args.Name, key, value)
 		if err != nil {
 			return err
 		}
 	case types.VolcanoTrainingJob:
 		args := job.Args().(*types.SubmitVolcanoJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"job.batch.volcano.sh",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource(args.Namespace, "job.batch.volcano.sh", args.Name, key, value)
 		if err != nil {
 			return err
 		}
 	case types.ETTrainingJob:
 		args := job.Args().(*types.SubmitETJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"trainingjobs.kai.alibabacloud.com",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource(args.Namespace, "trainingjobs.kai.alibabacloud.com", args.Name, key, value)
 		if err != nil {
 			return err
 		}
 	case types.SparkTrainingJob:
 		args := job.Args().(*types.SubmitSparkJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"sparkapplications.sparkoperator.k8s.io",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource(args.Namespace, "sparkapplications.sparkoperator.k8s.io", args.Name, key, value)
 		if err != nil {
 			return err
 		}
 	case types.DeepSpeedTrainingJob:
 		args := job.Args().(*types.SubmitDeepSpeedJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"trainingjobs.kai.alibabacloud.com",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource(args.Namespace, "trainingjobs.kai.alibabacloud.com", args.Name, key, value)
 		if err != nil {
 			return err
 		}
 	case types.RayJob:
 		args := job.Args().(*types.SubmitRayJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"rayjobs.ray.io",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource(args.Namespace, "rayjobs.ray.io", args.Name, key, value)
 		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// labelResource adds the label to the object by a merge patch
+func labelResource(namespace, resource, name, key, value string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]string{key: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	applier, err := newDefaultApplier()
+	if err != nil {
+		return err
+	}
+	_, err = applier.Patch(context.TODO(), resource+"/"+name, namespace, k8stypes.MergePatchType, patch)
+	return err
+}
@@ -15,9 +15,12 @@
 package workflow
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/kubeflow/arena/pkg/apis/config"
 	"github.com/kubeflow/arena/pkg/util/helm"
 	"github.com/kubeflow/arena/pkg/util/kubeclient"
 	"github.com/kubeflow/arena/pkg/util/kubectl"
@@ -28,6 +31,9 @@ import (
 	"github.com/kubeflow/arena/pkg/apis/types"
 )
 
+// horovodChartName is the name of the chart of the horovod jobs, it is the app label of their resources
+const horovodChartName = "tf-horovod"
+
 /**
 *	delete training job with the job name
 **/
@@ -37,6 +43,9 @@ func DeleteJob(name, namespace, trainingType string) error {
 
 	appInfoFileName, err := kubectl.SaveAppConfigMapToFile(jobName, "app", namespace)
 	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return deleteJobByLabels(name, namespace, trainingType)
+		}
 		log.Debugf("Failed to SaveAppConfigMapToFile due to %v", err)
 		return err
 	}
@@ -56,6 +65,51 @@ func DeleteJob(name, namespace, trainingType string) error {
 	return nil
 }
 
+/**
+*	delete the resources of the training job by the labels of the charts when its configmap is missing
+**/
+
+func deleteJobByLabels(name, namespace, trainingType string) error {
+	selector := jobLabelSelector(name, trainingType)
+	arenaConfiger := config.GetArenaConfiger()
+	if arenaConfiger.IsIsolateUserInNamespace() && !arenaConfiger.IsAdminUser() {
+		selector = fmt.Sprintf("%s,%s=%s", selector, types.UserNameIdLabel, arenaConfiger.GetUser().GetId())
+	}
+	log.Debugf("the configmap of job %s is not found, discover its resources by labels %s", name, selector)
+	deleted, err := kubectl.UninstallAppsWithLabels(selector, namespace)
+	if err != nil {
+		return err
+	}
+	if len(deleted) == 0 {
+		return types.ErrTrainingJobNotFound
+	}
+	log.Infof("The configmap of job %s is not found, the resources discovered by labels are deleted: %s", name, strings.Join(deleted, ", "))
+	return nil
+}
+
+// jobLabelSelector returns the selector of the resources created by the chart of the training job, the charts
+// label them with the release name and their app name which is the training type except for tf-horovod
+func jobLabelSelector(name, trainingType string) string {
+	apps := []string{trainingType}
+	if trainingType == string(types.HorovodTrainingJob) {
+		// the configmap of tf-horovod is labeled with the full name of the chart instead of its name
+		fullName := name
+		if !strings.Contains(name, horovodChartName) {
+			fullName = fmt.Sprintf("%s-%s", name, horovodChartName)
+		}
+		apps = []string{horovodChartName, strings.TrimSuffix(truncate(fullName, 63), "-")}
+	}
+	return fmt.Sprintf("app in (%s),release=%s", strings.Join(apps, ","), name)
+}
+
+// truncate returns the first n bytes of value like the trunc function of the charts
+func truncate(value string, n int) string {
+	if len(value) > n {
+		return value[:n]
+	}
+	return value
+}
+
 /**
 *	Submit operation, scaleIn or scaleOut
 **/
@@ -99,9 +153,9 @@ func SubmitOps(name string, trainingType string, namespace string, values interf
 	result, err := kubectl.InstallApps(template, namespace)
 	fmt.Printf("%s", result)
 	if err != nil {
-		// clean configmap
-		log.Infof("clean up the config map %s because creating application failed.", name)
-		log.Warnf("Please clean up the training job by using `arena delete %s --type %s`", name, trainingType)
+		if errors.Is(err, kubectl.ErrRollbackIncomplete) {
+			log.Warnf("Please clean up the training job by using `arena delete %s --type %s`", name, trainingType)
+		}
 		return err
 	}
 
@@ -196,7 +250,9 @@ func SubmitJob(name string, trainingType string, namespace string, values interf
 			log.Infof("Successfully clean up the config map %s in namespace %s because creating application failed.", configName, namespace)
 		}
 
-		log.Warnf("Please clean up the %s job", name)
+		if errors.Is(err, kubectl.ErrRollbackIncomplete) {
+			log.Warnf("Please clean up the %s job", name)
+		}
 		return err
 	}
 
@@ -230,3 +286,61 @@ func SubmitJob(name string, trainingType string, namespace string, values interf
 
 	return nil
 }
+
+/**
+*	Dry run of submitting a job, print the rendered manifests without creating anything
+**/
+
+func DryRunJob(name string, namespace string, values interface{}, chart string, strategy types.DryRunStrategy, options ...string) error {
+	// 1. Generate value file
+	valueFileName, err := helm.GenerateValueFile(values)
+	if valueFileName != "" {
+		defer removeTempFile(valueFileName)
+	}
+	if err != nil {
+		return err
+	}
+
+	// 2. Generate Template file
+	helmBinary := viper.GetBool("helm-binary")
+	var template string
+	if helmBinary {
+		template, err = helm.GenerateHelmTemplateLegacy(name, namespace, valueFileName, chart, options...)
+	} else {
+		template, err = helm.GenerateHelmTemplate(name, namespace, valueFileName, chart, options...)
+	}
+	if template != "" {
+		defer removeTempFile(template)
+	}
+	if err != nil {
+		return err
+	}
+
+	// 3. Print the manifests, the app configmap is not created
+	manifests, err := os.ReadFile(template)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s", manifests)
+
+	// 4. Validate the manifests by the api server
+	if strategy == types.DryRunServer {
+		result, err := kubectl.DryRunApps(template, namespace)
+		if err != nil {
+			return fmt.Errorf("server side dry run failed: %v, %s", err, result)
+		}
+		log.Infof("server side dry run passed:\n%s", result)
+	}
+
+	return nil
+}
+
+// removeTempFile removes a generated file, the files are kept with the debug log level
+func removeTempFile(fileName string) {
+	if log.GetLevel() == log.DebugLevel {
+		return
+	}
+	if err := os.Remove(fileName); err != nil {
+		log.Warnf("Failed to delete %s due to %v", fileName, err)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflow
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestDryRunJobRemovesTempFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("TMPDIR", tempDir)
+	t.Setenv("KUBECONFIG", filepath.Join(tempDir, "kubeconfig"))
+
+	values := map[string]interface{}{"image": "busybox"}
+	err := DryRunJob("test", "default", values, filepath.Join(tempDir, "missing-chart"), types.DryRunClient)
+	if err == nil {
+		t.Fatalf("expected the dry run of a missing chart to fail")
+	}
+	files, err := os.ReadDir(tempDir)
+	if err != nil {
+		t.Fatalf("failed to read %v: %v", tempDir, err)
+	}
+	for _, file := range files {
+		t.Errorf("expected the temp files to be removed when the dry run fails, found %v", file.Name())
+	}
+}
+
+func TestDeleteJobByLabelsSelector(t *testing.T) {
+	tests := []struct {
+		chart        string
+		trainingType types.TrainingJobType
+	}{
+		{chart: "tf-horovod", trainingType: types.HorovodTrainingJob},
+		{chart: "volcanojob", trainingType: types.VolcanoTrainingJob},
+		{chart: "sparkjob", trainingType: types.SparkTrainingJob},
+	}
+	for _, test := range tests {
+		chart, err := loader.Load(filepath.Join("..", "..", "charts", test.chart))
+		if err != nil {
+			t.Fatalf("failed to load chart %v: %v", test.chart, err)
+		}
+		values, err := chartutil.ToRenderValues(chart, chartutil.Values{}, chartutil.ReleaseOptions{Name: "test", Namespace: "default"}, nil)
+		if err != nil {
+			t.Fatalf("failed to build the values of chart %v: %v", test.chart, err)
+		}
+		manifests, err := engine.Render(chart, values)
+		if err != nil {
+			t.Fatalf("failed to render chart %v: %v", test.chart, err)
+		}
+		selector, err := labels.Parse(jobLabelSelector("test", string(test.trainingType)))
+		if err != nil {
+			t.Fatalf("failed to parse the selector of %v: %v", test.trainingType, err)
+		}
+		matched := 0
+		for file, manifest := range manifests {
+			if !strings.HasSuffix(file, ".yaml") {
+				continue
+			}
+			decoder := yaml.NewDecoder(strings.NewReader(manifest))
+			for {
+				var object struct {
+					Kind     string `yaml:"kind"`
+					Metadata struct {
+						Labels map[string]string `yaml:"labels"`
+					} `yaml:"metadata"`
+				}
+				if err := decoder.Decode(&object); err != nil {
+					break
+				}
+				if object.Kind == "" {
+					continue
+				}
+				if !selector.Matches(labels.Set(object.Metadata.Labels)) {
+					t.Errorf("expected the %v of %v to match the selector %v, got labels %v", object.Kind, file, selector, object.Metadata.Labels)
+					continue
+				}
+				matched++
+			}
+		}
+		if matched == 0 {
+			t.Errorf("expected the resources of chart %v to match the selector %v", test.chart, selector)
+		}
+	}
+
+	if selector := jobLabelSelector("my-tf-horovod", string(types.HorovodTrainingJob)); selector != "app in (tf-horovod,my-tf-horovod),release=my-tf-horovod" {
+		t.Errorf("expected the full name of the chart to be the release name containing it, got %v", selector)
+	}
+	if selector := jobLabelSelector("test", string(types.PytorchTrainingJob)); selector != "app in (pytorchjob),release=test" {
+		t.Errorf("unexpected selector of pytorchjob: %v", selector)
+	}
+}
+
+func TestAppWrapperJobMultiTaskRanks(t *testing.T) {
+	chart, err := loader.Load(filepath.Join("..", "..", "charts", "appwrapperjob"))
+	if err != nil {
+		t.Fatalf("failed to load chart appwrapperjob: %v", err)
+	}
+	userValues := chartutil.Values{
+		"innerJobType": "volcano",
+		"image":        "busybox",
+		"command":      "python train.py",
+		"nprocPerNode": "8",
+		"envs":         map[string]interface{}{"PET_NPROC_PER_NODE": "8"},
+		"tasks": []interface{}{
+			map[string]interface{}{"name": "coordinator", "replicas": 1, "gpuCount": 0},
+			map[string]interface{}{"name": "trainer", "replicas": 2, "gpuCount": 4, "nprocPerNode": "4"},
+			map[string]interface{}{"name": "worker", "replicas": 3, "gpuCount": 8},
+		},
+	}
+	values, err := chartutil.ToRenderValues(chart, userValues, chartutil.ReleaseOptions{Name: "test", Namespace: "default"}, nil)
+	if err != nil {
+		t.Fatalf("failed to build the values of chart appwrapperjob: %v", err)
+	}
+	manifests, err := engine.Render(chart, values)
+	if err != nil {
+		t.Fatalf("failed to render chart appwrapperjob: %v", err)
+	}
+	var appWrapper struct {
+		Spec struct {
+			Components []struct {
+				Template struct {
+					Spec struct {
+						Tasks []struct {
+							Name     string `yaml:"name"`
+							Template struct {
+								Spec struct {
+									Containers []struct {
+										Command []string `yaml:"command"`
+										Env     []struct {
+											Name  string `yaml:"name"`
+											Value string `yaml:"value"`
+										} `yaml:"env"`
+									} `yaml:"containers"`
+								} `yaml:"spec"`
+							} `yaml:"template"`
+						} `yaml:"tasks"`
+					} `yaml:"spec"`
+				} `yaml:"template"`
+			} `yaml:"components"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(manifests["appwrapperjob/templates/appwrapper.yaml"]), &appWrapper); err != nil {
+		t.Fatalf("failed to decode the AppWrapper: %v", err)
+	}
+	if len(appWrapper.Spec.Components) != 1 || len(appWrapper.Spec.Components[0].Template.Spec.Tasks) != 3 {
+		t.Fatalf("expected one Volcano Job with 3 tasks, got %+v", appWrapper.Spec)
+	}
+
+	expected := map[string]map[string]string{
+		// the CPU coordinator does not join the process group
+		"coordinator": {"MASTER_ADDR": "test-trainer-0.test", "NNODES": "5", "WORLD_SIZE": "32", "TASK_RANK_OFFSET": ""},
+		"trainer":     {"MASTER_ADDR": "test-trainer-0.test", "NNODES": "5", "WORLD_SIZE": "32", "TASK_RANK_OFFSET": "0", "LOCAL_WORLD_SIZE": "4", "PET_NPROC_PER_NODE": "4"},
+		"worker":      {"MASTER_ADDR": "test-trainer-0.test", "NNODES": "5", "WORLD_SIZE": "32", "TASK_RANK_OFFSET": "2", "LOCAL_WORLD_SIZE": "8", "PET_NPROC_PER_NODE": "8"},
+	}
+	for _, task := range appWrapper.Spec.Components[0].Template.Spec.Tasks {
+		container := task.Template.Spec.Containers[0]
+		envs := map[string]string{}
+		for _, env := range container.Env {
+			if _, ok := envs[env.Name]; ok {
+				t.Errorf("expected env %v of task %v to be set once", env.Name, task.Name)
+			}
+			envs[env.Name] = env.Value
+		}
+		for name, value := range expected[task.Name] {
+			if envs[name] != value {
+				t.Errorf("expected %v of task %v to be %q, got %q", name, task.Name, value, envs[name])
+			}
+		}
+		wrapped := strings.Contains(strings.Join(container.Command, " "), "TASK_RANK_OFFSET")
+		if wrapped != (task.Name != "coordinator") {
+			t.Errorf("expected only the process group tasks to export RANK, task %v got command %v", task.Name, container.Command)
+		}
+	}
+}
+
+func TestAppWrapperJobPodSets(t *testing.T) {
+	chart, err := loader.Load(filepath.Join("..", "..", "charts", "appwrapperjob"))
+	if err != nil {
+		t.Fatalf("failed to load chart appwrapperjob: %v", err)
+	}
+	tests := []struct {
+		name     string
+		values   chartutil.Values
+		expected []string
+	}{
+		{
+			name:     "tfjob with a chief and no workers",
+			values:   chartutil.Values{"innerJobType": "tfjob", "useChief": true, "workers": 0},
+			expected: []string{"template.spec.tfReplicaSpecs.Chief.template=1"},
+		},
+		{
+			name:     "mpijob",
+			values:   chartutil.Values{"innerJobType": "mpijob", "workers": 2},
+			expected: []string{"template.spec.template=1", "template.spec.template=2"},
+		},
+	}
+	for _, test := range tests {
+		test.values["image"] = "busybox"
+		test.values["command"] = "sleep 1"
+		values, err := chartutil.ToRenderValues(chart, test.values, chartutil.ReleaseOptions{Name: "test", Namespace: "default"}, nil)
+		if err != nil {
+			t.Fatalf("%s: failed to build the values: %v", test.name, err)
+		}
+		manifests, err := engine.Render(chart, values)
+		if err != nil {
+			t.Fatalf("%s: failed to render: %v", test.name, err)
+		}
+		var appWrapper struct {
+			Spec struct {
+				Components []struct {
+					PodSets []struct {
+						Path     string `yaml:"path"`
+						Replicas int    `yaml:"replicas"`
+					} `yaml:"podSets"`
+				} `yaml:"components"`
+			} `yaml:"spec"`
+		}
+		if err := yaml.Unmarshal([]byte(manifests["appwrapperjob/templates/appwrapper.yaml"]), &appWrapper); err != nil {
+			t.Fatalf("%s: failed to decode the AppWrapper: %v", test.name, err)
+		}
+		podSets := []string{}
+		for _, component := range appWrapper.Spec.Components {
+			for _, podSet := range component.PodSets {
+				podSets = append(podSets, fmt.Sprintf("%v=%v", podSet.Path, podSet.Replicas))
+			}
+		}
+		if !reflect.DeepEqual(podSets, test.expected) {
+			t.Errorf("%s: expected podSets %v, got %v", test.name, test.expected, podSets)
+		}
+	}
+}